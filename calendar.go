@@ -0,0 +1,77 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// Calendar is the calendar system used to interpret dates
+type Calendar int
+
+const (
+	// Gregorian interprets all dates in the proleptic Gregorian calendar
+	Gregorian Calendar = iota
+	// Julian interprets dates before the Gregorian reform (1582-10-15) as Julian dates
+	Julian
+)
+
+// isBeforeGregorianReform reports whether the Julian date is before 1582-10-05,
+// the day that became 1582-10-15 in the Gregorian calendar
+func isBeforeGregorianReform(year, month, day int) bool {
+	if year != 1582 {
+		return year < 1582
+	}
+
+	if month != 10 {
+		return month < 10
+	}
+
+	return day < 5
+}
+
+func julianToJDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+func jdnToGregorian(jdn int) (int, int, int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+
+	day := e - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := 100*b + d - 4800 + m/10
+
+	return year, month, day
+}
+
+func julianToGregorian(year, month, day int) (int, int, int) {
+	return jdnToGregorian(julianToJDN(year, month, day))
+}
+
+// daysIn returns the number of days in the month, which is in the Julian calendar before the Gregorian reform if Julian is set
+func (pt *ParseTime) daysIn(year, month int) int {
+	// every fourth year is a leap year in the Julian calendar (1500-02-29)
+	if pt.calendar == Julian && month == 2 && year%4 == 0 && isBeforeGregorianReform(year, month, 1) {
+		return 29
+	}
+
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// DayOfYear returns the day of the year of t, in the range [1,365] for non-leap years, and [1,366] in leap years
+func DayOfYear(t time.Time) int {
+	return t.YearDay()
+}
+
+// JulianDayNumber returns the (astronomical) Julian Day Number of t, e.g. 2000-01-01 12:00 UTC is 2451545.0
+func JulianDayNumber(t time.Time) float64 {
+	// 1970-01-01 00:00 UTC is JD 2440587.5
+	return float64(t.Unix())/86400 + float64(t.Nanosecond())/(86400*1e9) + 2440587.5
+}
@@ -0,0 +1,67 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCalendar(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.Parse("1582-10-04 12:00:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(1582, 10, 4, 12, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetCalendar(Julian)
+
+	times := []TestTime{
+		{
+			Value: "1582-10-04 12:00:00Z",
+			Time:  time.Date(1582, 10, 14, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "1066-10-14",
+			Time:  time.Date(1066, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "1500-02-29",
+			Time:  time.Date(1500, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "1582-10-15",
+			Time:  time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2006-01-02 15:04:05",
+			Time:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time")
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error")
+	}
+}
+
+func TestDayOfYear(test *testing.T) {
+	assert := assert.New(test)
+
+	assert.Equal(1, DayOfYear(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), "Incorrect day of year")
+	assert.Equal(60, DayOfYear(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)), "Incorrect day of year")
+	assert.Equal(366, DayOfYear(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)), "Incorrect day of year")
+	assert.Equal(365, DayOfYear(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)), "Incorrect day of year")
+}
+
+func TestJulianDayNumber(test *testing.T) {
+	assert := assert.New(test)
+
+	assert.Equal(2451545.0, JulianDayNumber(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)), "Incorrect Julian Day Number")
+	assert.Equal(2440587.5, JulianDayNumber(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)), "Incorrect Julian Day Number")
+	assert.Equal(2451545.0, JulianDayNumber(time.Date(2000, 1, 1, 21, 0, 0, 0, time.FixedZone("JST", 9*3600))), "Incorrect Julian Day Number")
+	assert.InDelta(2299160.5, JulianDayNumber(time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)), 1e-9, "Incorrect Julian Day Number")
+}
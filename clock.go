@@ -0,0 +1,24 @@
+package parsetime
+
+import (
+	"time"
+)
+
+// Clock provides the current time
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock is a Clock that always returns the same time
+type FixedClock time.Time
+
+// Now returns the fixed time
+func (c FixedClock) Now() time.Time {
+	return time.Time(c)
+}
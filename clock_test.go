@@ -0,0 +1,71 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetClock(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)))
+
+	times := []TestTime{
+		{
+			Value: "15:04:05",
+			Time:  time.Date(2024, 3, 10, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			Value: "11:04 PM",
+			Time:  time.Date(2024, 3, 10, 23, 4, 0, 0, time.UTC),
+		},
+		{
+			Value: "Jan 02 15:04:05",
+			Time:  time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			Value: "15:04:05 +09:00",
+			Time:  time.Date(2024, 3, 10, 15, 4, 5, 0, time.FixedZone("", 9*3600)),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time")
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error")
+	}
+}
+
+func TestFixedClock(test *testing.T) {
+	assert := assert.New(test)
+
+	t := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	clock := FixedClock(t)
+
+	assert.Equal(t, clock.Now(), "Incorrect time")
+}
+
+func TestParseSince(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)))
+
+	d, err := p.ParseSince("2024-01-15T12:00:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(2*time.Hour+30*time.Minute, d, "Parse error")
+
+	d, err = p.ParseSince("2024-01-15T14:30:00+09:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(9*time.Hour, d, "Parse error")
+
+	d, err = p.ParseSince("2024-01-16T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(-24*time.Hour, d, "Parse error")
+
+	_, err = p.ParseSince("invalid")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+}
@@ -1,64 +1,60 @@
 package parsetime
 
 import (
+	"regexp"
+	"sort"
 	"strings"
 )
 
 const (
 	year         = `(2[0-9]{3}|19[7-9][0-9])`
+	historicYear = `([01][0-9]{3})`
 	month        = `(1[012]|0?[1-9])`
 	day          = `([12][0-9]|3[01]|0?[1-9])`
 	hour         = `(2[0-3]|[01]?[0-9])`
 	min          = `([0-5]?[0-9])`
-	sec          = min
-	nsec         = `(?:[.])?([0-9]{1,9})?`
-	weekday      = `(?:Mon|Monday|Tue|Tuesday|Wed|Wednesday|Thu|Thursday|Fri|Friday|Sat|Saturday|Sun|Sunday)`
-	monthAbbr    = `(Jan|January|Feb|Februray|Mar|March|Apr|April|May|Jun|June|Jul|July|Aug|August|Sep|September|Oct|October|Nov|November|Dec|December|1[012]|0?[1-9])`
-	offset       = `(Z|[+-][01][1-9]:[0-9]{2})?`
-	zone         = `(?:[a-zA-Z0-9+-]{3,6})?`
+	// days up to 99 (2024-01-32) are matched by ISO8601 for ParseComponents, and rejected by Parse
+	isoDay = `(3[01]|[12][0-9]|[3-9][0-9]|0?[1-9])`
+	// 60 is a leap second
+	sec       = `(60|[0-5]?[0-9])`
+	nsec      = `(?:[.])?([0-9]{1,9})?`
+	isoNsec   = `(?:[.,])?([0-9]{1,9})?`
+	weekday   = `(?:Monday|Mon|Tuesday|Tue|Wednesday|Wed|Thursday|Thu|Friday|Fri|Saturday|Sat|Sunday|Sun)`
+	monthAbbr = `(Jan|January|Feb|February|Februray|Mar|March|Apr|April|May|Jun|June|Jul|July|Aug|August|Sep|September|Oct|October|Nov|November|Dec|December|1[012]|0?[1-9])`
+	// offsets out of range (+15:00) are matched to be rejected
+	offset       = `(Z|[+-][0-9]{2}:[0-9]{2})?`
+	zone         = `([a-zA-Z0-9+-]{3,6})?`
 	ymdSep       = `[ /.-]?`
 	hmsSep       = `[ :.]?`
 	t            = `(?:t|T|\s*)?`
 	s            = `(?:\s*)?`
-	ampm         = `([aApP][mM])`
 	ampmHour     = `(1[01]|[0]?[0-9])`
-	shortYear    = `(2[0-9]{3}|19[7-9][0-9]|[0-9]{2})`
-	offsetZone   = `([+-][01][1-9]:[0-9]{2}|[a-zA-Z0-9+-]{3,6})?`
-	usOffsetZone = `(?:[(])?([+-][01][1-9]:[0-9]{2}|[a-zA-Z0-9+-]{3,6})?(?:[)])?`
+	shortYear    = `(2[0-9]{3}|19[7-9][0-9]|'?[0-9]{2})`
+	offsetZone   = `([+-](?:0[0-9]|1[0-4]):[0-9]{2}|[a-zA-Z0-9+-]{3,6}|[zZ])?`
+	usOffsetZone = `(?:[(])?([+-](?:0[0-9]|1[0-4]):[0-9]{2}|[a-zA-Z0-9+-]{3,6}|[zZ])?(?:[)])?`
 )
 
 // Regular expressions
 var (
 	// ISO8601, RFC3339
-	ISO8601 = strings.Join([]string{
-		`(?:`, year, ymdSep, month, ymdSep, day, `)?`, t,
-		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
-		s, offset, s, zone,
-	}, "")
+	ISO8601 = iso8601Pattern()
 
 	// RFC822, RFC850, RFC1123
-	RFC8xx1123 = strings.Join([]string{
-		`(?:`, weekday, `,?`, s, `)?`, day, ymdSep, monthAbbr, ymdSep, shortYear,
-		hmsSep, `(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
-		s, offsetZone,
-	}, "")
+	RFC8xx1123 = rfc8xx1123Pattern()
 
-	ANSIC = strings.Join([]string{
-		`(?:`, weekday, s, `)?`, monthAbbr, ymdSep, day, ymdSep,
-		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
-		s, `(?:`, offsetZone, s, year, `)?`,
-	}, "")
+	ANSIC = ansicPattern()
 
-	US = strings.Join([]string{
-		`(?:`, monthAbbr, ymdSep, day, `(?:,)?`, ymdSep, shortYear, `)?`, s, `(?:at)?`, s,
-		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
-		s, ampm, `?`, s, usOffsetZone,
-	}, "")
+	US = usPattern()
+
+	// AM/PM markers recognized by the US parser
+	amMarkers = []string{"AM"}
+	pmMarkers = []string{"PM"}
 
 	Months = map[string]int{
 		"Jan":       1,
 		"January":   1,
 		"Feb":       2,
+		"February":  2,
 		"Februray":  2,
 		"Mar":       3,
 		"March":     3,
@@ -80,4 +76,105 @@ var (
 		"Dec":       12,
 		"December":  12,
 	}
+
+	// month abbreviations registered by RegisterMonthAbbreviations
+	monthAbbreviations = map[string]int{}
 )
+
+// RFC2822 timezone abbreviations and their offsets
+var rfc2822Offsets = map[string]int{
+	"EST": -5 * 3600,
+	"EDT": -4 * 3600,
+	"CST": -6 * 3600,
+	"CDT": -5 * 3600,
+	"MST": -7 * 3600,
+	"MDT": -6 * 3600,
+	"PST": -8 * 3600,
+	"PDT": -7 * 3600,
+}
+
+func ampmPattern() string {
+	markers := make([]string, 0, len(amMarkers)+len(pmMarkers))
+	for _, marker := range amMarkers {
+		markers = append(markers, regexp.QuoteMeta(marker))
+	}
+	for _, marker := range pmMarkers {
+		markers = append(markers, regexp.QuoteMeta(marker))
+	}
+
+	return `((?i:` + strings.Join(markers, "|") + `))`
+}
+
+// monthPattern returns monthAbbr with the registered month abbreviations, which are tried first
+func monthPattern() string {
+	if len(monthAbbreviations) == 0 {
+		return monthAbbr
+	}
+
+	names := make([]string, 0, len(monthAbbreviations))
+	for name := range monthAbbreviations {
+		names = append(names, name)
+	}
+	// the longest first, so that "Sept" is not matched as "Sep"
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+		return names[i] < names[j]
+	})
+
+	for i, name := range names {
+		names[i] = regexp.QuoteMeta(name)
+	}
+
+	return `(` + strings.Join(names, "|") + `|` + strings.TrimPrefix(monthAbbr, `(`)
+}
+
+// isoMonthPattern returns monthPattern with months up to 99 (2024-13-01), which are matched for ParseComponents and rejected by Parse
+func isoMonthPattern() string {
+	return `(1[3-9]|[2-9][0-9]|` + strings.TrimPrefix(monthPattern(), `(`)
+}
+
+// isoDateSeps are the separators of the ISO8601 date, which are the same between the year, month and day (2024.01-15 is rejected)
+var isoDateSeps = []string{`-`, `/`, `[.]`, ` `, ``}
+
+func iso8601Pattern() string {
+	dates := make([]string, 0, len(isoDateSeps)+1)
+	for _, sep := range isoDateSeps {
+		dates = append(dates, year+sep+isoMonthPattern()+sep+isoDay)
+	}
+	dates = append(dates, historicYear+`-`+isoMonthPattern()+`-`+isoDay)
+	// mixed separators are matched to be rejected
+	dates = append(dates, `(`+year+ymdSep+isoMonthPattern()+ymdSep+isoDay+`)`)
+
+	return strings.Join([]string{
+		`(?:`, strings.Join(dates, `|`), `)?`, t,
+		`(?:`, hour, `(?:[ :.]`, min, `|([0-5][0-9]))`, hmsSep, sec, `?`, isoNsec, `|([0-9]{2}))?`,
+		s, offset, s, zone,
+	}, "")
+}
+
+func rfc8xx1123Pattern() string {
+	return strings.Join([]string{
+		`(?:`, weekday, `,?`, s, `)?`, day, ymdSep, monthPattern(), ymdSep, shortYear,
+		hmsSep, `(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+		s, offsetZone,
+	}, "")
+}
+
+func ansicPattern() string {
+	return strings.Join([]string{
+		`(?:`, weekday, s, `)?`, monthPattern(), `(?:[/.-]|\s*)`, day, ymdSep,
+		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+		s, `(?:`, offsetZone, s, year, `)?`,
+	}, "")
+}
+
+func usPattern() string {
+	return strings.Join([]string{
+		`(?:`, weekday, `,?`, s, `)?`,
+		`(?:`, monthPattern(), ymdSep, day, `(?:,)?`, ymdSep, shortYear, `)?`, s, `(?i:at)?`, s,
+		`(?:`, hour, hmsSep, min, hmsSep, sec, `?`, nsec, `)?`,
+		s, ampmPattern(), `?`, s, usOffsetZone,
+	}, "")
+}
@@ -0,0 +1,99 @@
+package parsetime
+
+import (
+	"errors"
+	"time"
+)
+
+var errNonexistentTime = errors.New("Nonexistent local time")
+
+// DSTGapPolicy is how a local time in a DST gap (e.g. 02:30 when the clocks spring forward from 02:00 to 03:00) is resolved
+type DSTGapPolicy int
+
+const (
+	// DSTGapShiftBackward moves the time back by the length of the gap (e.g. 02:30 becomes 01:30), as time.Date does
+	DSTGapShiftBackward DSTGapPolicy = iota
+	// DSTGapShiftForward moves the time forward by the length of the gap (e.g. 02:30 becomes 03:30)
+	DSTGapShiftForward
+	// DSTGapReject returns an error
+	DSTGapReject
+)
+
+// SetDSTGapPolicy sets how a local time in a DST gap is resolved
+func (pt *ParseTime) SetDSTGapPolicy(policy DSTGapPolicy) *ParseTime {
+	pt.dstGapPolicy = policy
+
+	return pt
+}
+
+// wallClock returns the wall clock of t as a UTC time
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// resolveDSTGap applies the DST gap policy to t, the time.Date result of the wall clock want
+func (pt *ParseTime) resolveDSTGap(t, want time.Time) (time.Time, error) {
+	diff := want.Sub(wallClock(t))
+	if diff == 0 {
+		return t, nil
+	}
+
+	switch pt.dstGapPolicy {
+	case DSTGapReject:
+		return time.Time{}, errNonexistentTime
+	case DSTGapShiftForward:
+		if diff > 0 {
+			t = t.Add(diff)
+		}
+	case DSTGapShiftBackward:
+		if diff < 0 {
+			t = t.Add(diff)
+		}
+	}
+
+	return t, nil
+}
+
+// DSTOverlapPolicy is how a local time in a DST overlap (e.g. 01:30 when the clocks fall back from 02:00 to 01:00) is resolved
+type DSTOverlapPolicy int
+
+const (
+	// DSTOverlapEarliest picks the earlier instant (e.g. 01:30 EDT), as time.Date does
+	DSTOverlapEarliest DSTOverlapPolicy = iota
+	// DSTOverlapLatest picks the later instant (e.g. 01:30 EST)
+	DSTOverlapLatest
+)
+
+// SetDSTOverlapPolicy sets how a local time in a DST overlap is resolved
+func (pt *ParseTime) SetDSTOverlapPolicy(policy DSTOverlapPolicy) *ParseTime {
+	pt.dstOverlapPolicy = policy
+
+	return pt
+}
+
+// resolveDSTOverlap applies the DST overlap policy to t, the time.Date result of the wall clock want
+func (pt *ParseTime) resolveDSTOverlap(t, want time.Time) time.Time {
+	earliest, latest := t, t
+
+	// the offsets in effect around t are the candidates for the wall clock
+	for _, d := range []time.Duration{-12 * time.Hour, 12 * time.Hour} {
+		_, offset := t.Add(d).Zone()
+		candidate := want.Add(-time.Duration(offset) * time.Second).In(t.Location())
+		if !wallClock(candidate).Equal(want) {
+			continue
+		}
+
+		if candidate.Before(earliest) {
+			earliest = candidate
+		}
+		if candidate.After(latest) {
+			latest = candidate
+		}
+	}
+
+	if pt.dstOverlapPolicy == DSTOverlapLatest {
+		return latest
+	}
+
+	return earliest
+}
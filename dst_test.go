@@ -0,0 +1,73 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDSTGapPolicy(test *testing.T) {
+	assert := assert.New(test)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	p, _ := NewParseTime(loc)
+
+	// 2024-03-10 02:00 EST springs forward to 03:00 EDT
+	t, err := p.Parse("2024-03-10 02:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetDSTGapPolicy(DSTGapShiftForward)
+	t, err = p.Parse("2024-03-10 02:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+	assert.Equal(3, t.Hour(), "Parse error")
+
+	p.SetDSTGapPolicy(DSTGapShiftBackward)
+	t, err = p.Parse("2024-03-10 02:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+	assert.Equal(1, t.Hour(), "Parse error")
+
+	p.SetDSTGapPolicy(DSTGapReject)
+	_, err = p.Parse("2024-03-10 02:30:00")
+	assert.Equal(errNonexistentTime, err, "Nonexistent local time")
+
+	t, err = p.Parse("2024-03-10 03:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("2024-03-10 02:30:00 -05:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestSetDSTOverlapPolicy(test *testing.T) {
+	assert := assert.New(test)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	p, _ := NewParseTime(loc)
+
+	// 2024-11-03 02:00 EDT falls back to 01:00 EST
+	earliest, err := p.Parse("2024-11-03 01:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC).Unix(), earliest.Unix(), "Parse error")
+
+	p.SetDSTOverlapPolicy(DSTOverlapLatest)
+	latest, err := p.Parse("2024-11-03 01:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC).Unix(), latest.Unix(), "Parse error")
+	assert.Equal(time.Hour, latest.Sub(earliest), "Parse error")
+	assert.Equal(1, latest.Hour(), "Parse error")
+
+	p.SetDSTOverlapPolicy(DSTOverlapEarliest)
+	t, err := p.Parse("2024-11-03 01:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(earliest.Unix(), t.Unix(), "Parse error")
+
+	p.SetDSTOverlapPolicy(DSTOverlapLatest)
+	t, err = p.Parse("2024-11-03 03:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 11, 3, 8, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
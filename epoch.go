@@ -0,0 +1,320 @@
+package parsetime
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// "@" marks Unix time in seconds like GNU date (@1705329000), and a unit suffix marks its scale (1705329000000ms)
+	reEpoch = regexp.MustCompile(`^(@)?([0-9]+)(?:[.]([0-9]+))?(s|ms|us|µs|ns)?$`)
+	// seconds with optional sign and fraction (-123.5)
+	reSignedSeconds = regexp.MustCompile(`^(-)?([0-9]+)(?:[.]([0-9]+))?$`)
+	reFileTime      = regexp.MustCompile(`^([0-9]+)$`)
+	reHexEpoch      = regexp.MustCompile(`^0[xX]([0-9a-fA-F]{1,16})$`)
+
+	digitGrouping = strings.NewReplacer(",", "", " ", "", "_", "")
+	// digits grouped by thousands with ",", "_" or " " (1,705,329,000)
+	reDigitGrouping = regexp.MustCompile(`^[0-9]{1,3}(?:(?:,[0-9]{3})+|(?:_[0-9]{3})+|(?: [0-9]{3}){2,})(?:[.][0-9]+)?$`)
+)
+
+// SetStripDigitGrouping sets whether digit grouping separators (e.g. "1,705,329,000") are removed before parsing numeric values
+func (pt *ParseTime) SetStripDigitGrouping(strip bool) *ParseTime {
+	pt.stripDigitGrouping = strip
+
+	return pt
+}
+
+// SetHexEpoch sets whether hexadecimal values with a "0x" prefix (e.g. "0x65A5B3A8") are parsed as Unix time in seconds
+func (pt *ParseTime) SetHexEpoch(hex bool) *ParseTime {
+	pt.hexEpoch = hex
+
+	return pt
+}
+
+// NumericInterpretation is how a numeric value that is both a compact date and Unix time (e.g. "20240115143005") is interpreted
+type NumericInterpretation int
+
+const (
+	// CalendarFirst interprets the value as a compact date (YYYYMMDD or YYYYMMDDHHMMSS)
+	CalendarFirst NumericInterpretation = iota
+	// EpochFirst interprets the value as Unix time, whose scale is given by the number of digits
+	EpochFirst
+)
+
+// SetNumericInterpretation sets how a numeric value that is both a compact date and Unix time is interpreted (default CalendarFirst)
+func (pt *ParseTime) SetNumericInterpretation(interpretation NumericInterpretation) *ParseTime {
+	pt.numericInterpretation = interpretation
+
+	return pt
+}
+
+// epochUnitScales is the number of fractional digits of Unix time with a unit suffix
+var epochUnitScales = map[string]int{
+	"s":  0,
+	"ms": 3,
+	"us": 6,
+	"µs": 6,
+	"ns": 9,
+}
+
+// epochScale returns the number of fractional digits of a Unix time with n integer digits:
+// up to 10 digits are seconds, then milliseconds, microseconds and nanoseconds
+func epochScale(n int) int {
+	switch {
+	case n <= 10:
+		return 0
+	case n <= 13:
+		return 3
+	case n <= 16:
+		return 6
+	default:
+		return 9
+	}
+}
+
+func epochToUnix(integer, fraction string, scale int) (int64, int64, error) {
+	if scale > len(integer) {
+		integer = strings.Repeat("0", scale-len(integer)) + integer
+	}
+
+	secs := integer[:len(integer)-scale]
+	fraction = integer[len(integer)-scale:] + fraction
+
+	var sec, nsec int64
+	var err error
+
+	if secs != "" {
+		sec, err = strconv.ParseInt(secs, 10, 64)
+		if err != nil {
+			return sec, nsec, err
+		}
+	}
+
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+
+	if fraction != "" {
+		nsec, err = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	}
+
+	return sec, nsec, err
+}
+
+func timeToDateTime(t time.Time) dateTime {
+	return dateTime{
+		year:  t.Year(),
+		month: int(t.Month()),
+		day:   t.Day(),
+		hour:  t.Hour(),
+		min:   t.Minute(),
+		sec:   t.Second(),
+		nsec:  t.Nanosecond(),
+		loc:   t.Location(),
+	}
+}
+
+// epochDigits returns value without surrounding spaces, and without digit grouping if it is stripped
+func (pt *ParseTime) epochDigits(value string) string {
+	value = strings.TrimSpace(value)
+	if pt.stripDigitGrouping {
+		value = digitGrouping.Replace(value)
+	}
+
+	return value
+}
+
+// isCompactDate reports whether the digits are a plausible YYYYMMDD or YYYYMMDDHHMMSS date
+func isCompactDate(digits string) bool {
+	if len(digits) != 8 && len(digits) != 14 {
+		return false
+	}
+
+	var fields []int
+	for i := 4; i < len(digits); i += 2 {
+		n, _ := strconv.Atoi(digits[i : i+2])
+		fields = append(fields, n)
+	}
+
+	year, _ := strconv.Atoi(digits[:4])
+	if year < 1000 || fields[0] < 1 || fields[0] > 12 || fields[1] < 1 || fields[1] > 31 {
+		return false
+	}
+
+	return len(fields) == 2 || (fields[2] < 24 && fields[3] < 60 && fields[4] < 60)
+}
+
+// numericFormat returns the name of the only format that Parse tries for a numeric value:
+// ISO8601 for compact dates (20240115, 20240115143005) unless EpochFirst is set,
+// Epoch for other values of 9 or more digits, values with "@" or a unit suffix and hexadecimal values if SetHexEpoch is set.
+// 8 digits that are not a plausible compact date (20241301) are an error unless EpochFirst is set,
+// and shorter values are only tried as ISO8601 time (1530, 143005).
+// It returns "" for values that are not numeric, for which Parse does not try Epoch.
+// Grouped digits (1,705,329,000) are an error unless SetStripDigitGrouping is set.
+func (pt *ParseTime) numericFormat(value string) (string, error) {
+	if !pt.stripDigitGrouping && reDigitGrouping.MatchString(strings.TrimSpace(value)) {
+		return "", errInvalidDateTime
+	}
+
+	if pt.hexEpoch && reHexEpoch.MatchString(pt.epochDigits(value)) {
+		return "Epoch", nil
+	}
+
+	group := findSubmatch(reEpoch, pt.epochDigits(value), 4)
+	if len(group) == 0 {
+		return "", nil
+	}
+
+	if group[1] == "@" || group[4] != "" {
+		return "Epoch", nil
+	}
+
+	if group[3] == "" && pt.numericInterpretation == CalendarFirst {
+		if isCompactDate(group[2]) {
+			return "ISO8601", nil
+		}
+		if len(group[2]) == 8 {
+			return "", errInvalidDateTime
+		}
+	}
+
+	if len(group[2]) >= 8 {
+		return "Epoch", nil
+	}
+
+	return "ISO8601", nil
+}
+
+func (pt *ParseTime) parseEpoch(value string) (dateTime, int, error) {
+	var dt dateTime
+	var priority int
+
+	matched := strings.TrimSpace(value)
+
+	if pt.hexEpoch {
+		if group := findSubmatch(reHexEpoch, pt.epochDigits(value), 1); len(group) != 0 {
+			sec, err := strconv.ParseUint(group[1], 16, 64)
+			if err != nil || sec > math.MaxInt64 {
+				return dt, priority, errInvalidDateTime
+			}
+
+			dt = timeToDateTime(time.Unix(int64(sec), 0).UTC())
+			dt.format = "Epoch"
+			dt.matched = matched
+
+			return dt, priority, nil
+		}
+	}
+
+	group := findSubmatch(reEpoch, pt.epochDigits(value), 4)
+
+	if len(group) == 0 {
+		return dt, priority, errInvalidDateTime
+	}
+
+	// the unit suffix takes precedence over "@" and the number of digits
+	scale := epochScale(len(group[2]))
+	if unit, ok := epochUnitScales[group[4]]; ok {
+		scale = unit
+	} else if group[1] == "@" {
+		scale = 0
+	}
+
+	sec, nsec, err := epochToUnix(group[2], group[3], scale)
+	if err != nil {
+		return dt, priority, err
+	}
+
+	dt = timeToDateTime(time.Unix(sec, nsec).UTC())
+	dt.format = "Epoch"
+	dt.matched = matched
+
+	return dt, priority, nil
+}
+
+// Epoch parses Unix time (seconds since 1970-01-01 UTC, with optional fraction).
+// Values with more than 10 digits are treated as milliseconds, microseconds or nanoseconds by their length,
+// and values with a leading "@" (@1705329000) are always seconds.
+// A unit suffix ("s", "ms", "us", "µs" or "ns") sets the scale regardless of the length (1705329000000ms).
+// Hexadecimal seconds (0x65A5B3A8) are parsed if SetHexEpoch is set.
+func (pt *ParseTime) Epoch(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseEpoch, value)
+}
+
+// appleEpoch is 2001-01-01 00:00:00 UTC, the reference date of NSDate and Core Data, in Unix time
+const appleEpoch = 978307200
+
+func (pt *ParseTime) parseAppleEpoch(value string) (dateTime, int, error) {
+	var dt dateTime
+	var priority int
+
+	matched := strings.TrimSpace(value)
+	group := findSubmatch(reSignedSeconds, pt.epochDigits(value), 3)
+
+	if len(group) == 0 {
+		return dt, priority, errInvalidDateTime
+	}
+
+	sec, nsec, err := epochToUnix(group[2], group[3], 0)
+	if err != nil {
+		return dt, priority, err
+	}
+
+	if group[1] == "-" {
+		sec, nsec = -sec, -nsec
+	}
+
+	dt = timeToDateTime(time.Unix(appleEpoch+sec, nsec).UTC())
+	dt.format = "AppleEpoch"
+	dt.matched = matched
+
+	return dt, priority, nil
+}
+
+// AppleEpoch parses seconds since 2001-01-01 00:00:00 UTC (NSDate, Core Data), with optional sign and fraction
+func (pt *ParseTime) AppleEpoch(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseAppleEpoch, value)
+}
+
+const (
+	// fileTimeEpoch is 1601-01-01 00:00:00 UTC, the epoch of Windows FILETIME, in Unix time
+	fileTimeEpoch = -11644473600
+	// fileTimeTicks is the number of FILETIME intervals (100 nanoseconds) per second
+	fileTimeTicks = 10000000
+)
+
+func (pt *ParseTime) parseFileTime(value string) (dateTime, int, error) {
+	var dt dateTime
+	var priority int
+
+	matched := strings.TrimSpace(value)
+	group := findSubmatch(reFileTime, pt.epochDigits(value), 1)
+
+	if len(group) == 0 {
+		return dt, priority, errInvalidDateTime
+	}
+
+	// up to 30828-09-14, the maximum of int64
+	ticks, err := strconv.ParseInt(group[1], 10, 64)
+	if err != nil {
+		return dt, priority, err
+	}
+
+	sec := ticks/fileTimeTicks + fileTimeEpoch
+	nsec := ticks % fileTimeTicks * 100
+
+	dt = timeToDateTime(time.Unix(sec, nsec).UTC())
+	dt.format = "FileTime"
+	dt.matched = matched
+
+	return dt, priority, nil
+}
+
+// FileTime parses Windows FILETIME (100-nanosecond intervals since 1601-01-01 00:00:00 UTC)
+func (pt *ParseTime) FileTime(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseFileTime, value)
+}
@@ -0,0 +1,360 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpoch(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	times := []TestTime{
+		{
+			Value: "1705329000",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "1705329000.5",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 500000000, time.UTC),
+		},
+		{
+			Value: "1705329000123",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123000000, time.UTC),
+		},
+		{
+			Value: "1705329000123456",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123456000, time.UTC),
+		},
+		{
+			Value: "1705329000123456789",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123456789, time.UTC),
+		},
+		{
+			Value: "0",
+			Time:  time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "@1705329000",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "@1705329000.5",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 500000000, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Epoch(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time")
+		assert.Equal(tt.Time, t, "Parse error")
+	}
+
+	_, err := p.Epoch("2006-01-02")
+	assert.Equal(errInvalidDateTime, err, "Parse error")
+}
+
+func TestSetStripDigitGrouping(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	_, err := p.Epoch("1,705,329,000")
+	assert.Equal(errInvalidDateTime, err, "Parse error")
+
+	for _, value := range []string{"1,705,329,000", "1_705_329_000", "1 705 329 000.25"} {
+		_, err = p.Parse(value)
+		assert.Equal(errInvalidDateTime, err, "Parse error: "+value)
+	}
+
+	p.SetStripDigitGrouping(true)
+
+	t, err := p.Epoch("1,705,329,000")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC), t, "Parse error")
+
+	t, err = p.Parse("1,705,329,000")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC), t, "Parse error")
+
+	t, err = p.Epoch("1 705 329 000.25")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 250000000, time.UTC), t, "Parse error")
+}
+
+func TestParseNumeric(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)))
+
+	times := []struct {
+		Value  string
+		Time   time.Time
+		Format string
+	}{
+		{
+			Value:  "20240115",
+			Time:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			Format: "ISO8601",
+		},
+		{
+			Value:  "20240115143005",
+			Time:   time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC),
+			Format: "ISO8601",
+		},
+		{
+			Value:  "1705329000",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+			Format: "Epoch",
+		},
+		{
+			Value:  "1705329000123",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 123000000, time.UTC),
+			Format: "Epoch",
+		},
+		{
+			Value:  "123456789",
+			Time:   time.Unix(123456789, 0).UTC(),
+			Format: "Epoch",
+		},
+		{
+			Value:  "1530",
+			Time:   time.Date(2024, 3, 10, 15, 30, 0, 0, time.UTC),
+			Format: "ISO8601",
+		},
+		{
+			Value:  "@1705329000.5",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 500000000, time.UTC),
+			Format: "Epoch",
+		},
+		{
+			// "@" is always seconds
+			Value:  "@20240115",
+			Time:   time.Unix(20240115, 0).UTC(),
+			Format: "Epoch",
+		},
+	}
+
+	for _, tt := range times {
+		result, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.UnixNano(), result.Time.UnixNano(), "Parse error: "+tt.Value)
+		assert.Equal(tt.Format, result.Format, "Format error: "+tt.Value)
+	}
+
+	// short numbers are not Unix time, and 8 digits must be a plausible YYYYMMDD
+	for _, value := range []string{"0", "1", "12", "15", "20241301", "20240132", "99999999"} {
+		_, err := p.Parse(value)
+		assert.Equal(errInvalidDateTime, err, "Invalid date/time: "+value)
+	}
+}
+
+func TestSetHexEpoch(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	_, err := p.Epoch("0x65A5B3A8")
+	assert.NotEqual(nil, err, "Parse error")
+
+	p.SetHexEpoch(true)
+
+	for _, value := range []string{"0x65A5B3A8", "0x65a5b3a8", "0X65A5B3A8"} {
+		result, err := p.ParseDetailed(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(time.Unix(1705358248, 0).Unix(), result.Time.Unix(), "Parse error: "+value)
+		assert.Equal("Epoch", result.Format, "Format error: "+value)
+
+		t, err := p.Epoch(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(time.Unix(1705358248, 0).Unix(), t.Unix(), "Parse error: "+value)
+	}
+
+	// the decimal equivalent
+	t, err := p.Parse("1705358248")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 22, 37, 28, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	_, err = p.Epoch("0x65G5B3A8")
+	assert.NotEqual(nil, err, "Parse error")
+}
+
+func TestSetNumericInterpretation(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	result, err := p.ParseDetailed("20240115143005")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC).UnixNano(), result.Time.UnixNano(), "Parse error")
+	assert.Equal("ISO8601", result.Format, "Format error")
+
+	p.SetNumericInterpretation(EpochFirst)
+
+	// microseconds
+	result, err = p.ParseDetailed("20240115143005")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Unix(20240115, 143005000).UnixNano(), result.Time.UnixNano(), "Parse error")
+	assert.Equal("Epoch", result.Format, "Format error")
+
+	result, err = p.ParseDetailed("20240115")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Unix(20240115, 0).UnixNano(), result.Time.UnixNano(), "Parse error")
+	assert.Equal("Epoch", result.Format, "Format error")
+
+	p.SetNumericInterpretation(CalendarFirst)
+
+	result, err = p.ParseDetailed("20240115")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC).UnixNano(), result.Time.UnixNano(), "Parse error")
+	assert.Equal("ISO8601", result.Format, "Format error")
+}
+
+func TestEpochUnitSuffix(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	times := []TestTime{
+		{
+			Value: "1705329000s",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "1705329000.5s",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 500000000, time.UTC),
+		},
+		{
+			Value: "1705329000123ms",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123000000, time.UTC),
+		},
+		{
+			Value: "1705329000123456us",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123456000, time.UTC),
+		},
+		{
+			Value: "1705329000123456µs",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123456000, time.UTC),
+		},
+		{
+			Value: "1705329000123456789ns",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123456789, time.UTC),
+		},
+		// the suffix takes precedence over the number of digits
+		{
+			Value: "1705329000ms",
+			Time:  time.Date(1970, 1, 20, 17, 42, 9, 0, time.UTC),
+		},
+		{
+			Value: "1705329000000000s",
+			Time:  time.Unix(1705329000000000, 0).UTC(),
+		},
+		{
+			Value: "@1705329000000ms",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "1500ms",
+			Time:  time.Date(1970, 1, 1, 0, 0, 1, 500000000, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Epoch(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time, t, "Parse error: "+tt.Value)
+
+		t, err = p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.UnixNano(), t.UnixNano(), "Parse error: "+tt.Value)
+	}
+
+	for _, value := range []string{"1705329000 ms", "1705329000m", "1705329000sec"} {
+		_, err := p.Epoch(value)
+		assert.NotEqual(nil, err, "Parse error: "+value)
+	}
+}
+
+func TestAppleEpoch(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	times := []TestTime{
+		{
+			Value: "0",
+			Time:  time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "727021800",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "727021800.25",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 250000000, time.UTC),
+		},
+		{
+			Value: "-978307200",
+			Time:  time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "-0.5",
+			Time:  time.Date(2000, 12, 31, 23, 59, 59, 500000000, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.AppleEpoch(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time, t, "Parse error: "+tt.Value)
+	}
+
+	for _, value := range []string{"", "abc", "1.2.3", "+1"} {
+		_, err := p.AppleEpoch(value)
+		assert.NotEqual(nil, err, "Parse error: "+value)
+	}
+
+	p.SetRequireTimezone(true)
+	_, err := p.AppleEpoch("727021800")
+	assert.Equal(nil, err, "Invalid date/time")
+}
+
+func TestFileTime(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	times := []TestTime{
+		{
+			Value: "116444736000000000",
+			Time:  time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "0",
+			Time:  time.Date(1601, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "133498026001234567",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 123456700, time.UTC),
+		},
+		{
+			Value: "9223372036854775807",
+			Time:  time.Date(30828, 9, 14, 2, 48, 5, 477580700, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.FileTime(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time, t, "Parse error: "+tt.Value)
+	}
+
+	for _, value := range []string{"", "abc", "-1", "1.5", "9223372036854775808"} {
+		_, err := p.FileTime(value)
+		assert.NotEqual(nil, err, "Parse error: "+value)
+	}
+}
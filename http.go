@@ -0,0 +1,33 @@
+package parsetime
+
+import (
+	"strings"
+	"time"
+)
+
+// parseHTTPDate parses the HTTP-date formats of RFC7231 (IMF-fixdate, RFC850, asctime)
+func (pt *ParseTime) parseHTTPDate(value string) (dateTime, int, error) {
+	for _, parse := range []parseFunc{(*ParseTime).parseRFC8xx1123, (*ParseTime).parseANSIC} {
+		dt, priority, err := parse(pt, strings.TrimSpace(value))
+		if err != nil || priority != 0 {
+			continue
+		}
+
+		// HTTP dates are in GMT, and asctime has no timezone (Sun, 06 Nov 1994 08:49:37 JST is an error)
+		switch strings.ToUpper(dt.zone()) {
+		case "", "GMT", "UTC":
+			dt.loc = time.UTC
+		default:
+			return dt, priority, errInvalidTimezone
+		}
+
+		return dt, priority, nil
+	}
+
+	return dateTime{}, 0, errInvalidDateTime
+}
+
+// HTTPDate parses HTTP-date of RFC7231 (e.g. "Sun, 06 Nov 1994 08:49:37 GMT", "Sunday, 06-Nov-94 08:49:37 GMT", "Sun Nov  6 08:49:37 1994") in UTC
+func (pt *ParseTime) HTTPDate(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseHTTPDate, value)
+}
@@ -0,0 +1,34 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPDate(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.FixedZone("JST", 9*3600))
+
+	want := time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC)
+
+	for _, value := range []string{
+		"Sun, 06 Nov 1994 08:49:37 GMT",
+		"Sunday, 06-Nov-94 08:49:37 GMT",
+		"Sun Nov  6 08:49:37 1994",
+	} {
+		t, err := p.HTTPDate(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(want, t, "Parse error: "+value)
+	}
+
+	_, err := p.HTTPDate("Sun, 06 Nov 1994 08:49:37 GMT garbage")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+
+	for _, value := range []string{"Sun, 06 Nov 1994 08:49:37 JST", "Sun, 06 Nov 1994 08:49:37 +0900"} {
+		_, err = p.HTTPDate(value)
+		assert.Equal(errInvalidTimezone, err, "Invalid timezone: "+value)
+	}
+}
@@ -0,0 +1,175 @@
+package parsetime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	errInvalidDuration = errors.New("Invalid duration")
+	errInvalidInterval = errors.New("Invalid interval")
+	reISODuration      = regexp.MustCompile(`^([+-])?P(?:([0-9]+)Y)?(?:([0-9]+)M)?(?:([0-9]+)W)?(?:([0-9]+)D)?(?:T(?:([0-9]+)H)?(?:([0-9]+)M)?(?:([0-9]+(?:[.,][0-9]+)?)S)?)?$`)
+)
+
+// Duration is ISO8601 duration, whose years, months and days are calendar units
+type Duration struct {
+	Years, Months, Days int
+	// Time is the hours, minutes and seconds
+	Time time.Duration
+	// Negative reports whether the duration has a leading "-" (-PT1H)
+	Negative bool
+}
+
+// AddTo returns t plus the duration, or t minus the duration if it is negative.
+// Years, months and days are added with time.Time.AddDate, which normalizes overflowing days (2024-01-31 + P1M = 2024-03-02).
+func (d Duration) AddTo(t time.Time) time.Time {
+	return d.addTo(t, 1)
+}
+
+// addTo returns t plus the duration times sign (1 or -1)
+func (d Duration) addTo(t time.Time, sign int) time.Time {
+	if d.Negative {
+		sign = -sign
+	}
+
+	if sign < 0 {
+		return t.Add(-d.Time).AddDate(-d.Years, -d.Months, -d.Days)
+	}
+
+	return t.AddDate(d.Years, d.Months, d.Days).Add(d.Time)
+}
+
+// ParseDuration parses ISO8601 duration of years, months, weeks, days and time (e.g. "P1Y2M3DT4H30M", "P2W").
+// Weeks are added to the days as 7 days each.
+// A leading sign (-PT1H) is accepted as an extension to ISO8601, which has no negative durations.
+func ParseDuration(value string) (Duration, error) {
+	var d Duration
+
+	group := findSubmatch(reISODuration, value, 8)
+	if len(group) == 0 || !hasDateTime(group[2:]...) || strings.HasSuffix(value, "T") {
+		return d, errInvalidDuration
+	}
+
+	d.Negative = group[1] == "-"
+
+	var weeks int
+	dates := []*int{&d.Years, &d.Months, &weeks, &d.Days}
+	for i, date := range dates {
+		if group[i+2] == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(group[i+2])
+		if err != nil {
+			return d, err
+		}
+		*date = n
+	}
+	d.Days += weeks * 7
+
+	units := []time.Duration{time.Hour, time.Minute}
+	for i, unit := range units {
+		if group[i+6] == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(group[i+6])
+		if err != nil {
+			return d, err
+		}
+		d.Time += time.Duration(n) * unit
+	}
+
+	if group[8] != "" {
+		sec, err := strconv.ParseFloat(strings.Replace(group[8], ",", ".", 1), 64)
+		if err != nil {
+			return d, err
+		}
+		d.Time += time.Duration(sec * float64(time.Second))
+	}
+
+	return d, nil
+}
+
+func isISODuration(value string) bool {
+	return strings.HasPrefix(strings.TrimLeft(value, "+-"), "P")
+}
+
+// ParseInterval parses ISO8601 time interval (e.g. "2024-01-15T00:00:00Z/2024-01-16T00:00:00Z", "2024-01-15T00:00:00Z/PT1H", "PT1H/2024-01-16T00:00:00Z"),
+// and returns its start and end.
+// Years, months and days of the duration are calendar units (2024-01-31T00:00:00Z/P1M ends at 2024-03-02T00:00:00Z),
+// and a negative duration is subtracted from the start or added to the end (2024-01-15T00:00:00Z/-PT1H ends at 2024-01-14T23:00:00Z).
+func (pt *ParseTime) ParseInterval(value string) (time.Time, time.Time, error) {
+	var start, end time.Time
+
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) != 2 {
+		return start, end, errInvalidInterval
+	}
+
+	first, second := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+	switch {
+	case isISODuration(first) && isISODuration(second):
+		return start, end, errInvalidInterval
+	case isISODuration(first):
+		d, err := ParseDuration(first)
+		if err != nil {
+			return start, end, err
+		}
+
+		end, err = pt.Parse(second)
+		if err != nil {
+			return start, end, err
+		}
+
+		return d.addTo(end, -1), end, nil
+	case isISODuration(second):
+		d, err := ParseDuration(second)
+		if err != nil {
+			return start, end, err
+		}
+
+		start, err = pt.Parse(first)
+		if err != nil {
+			return start, end, err
+		}
+
+		return start, d.addTo(start, 1), nil
+	}
+
+	start, err := pt.Parse(first)
+	if err != nil {
+		return start, end, err
+	}
+
+	end, err = pt.Parse(second)
+	if err != nil {
+		return start, end, err
+	}
+
+	return start, end, nil
+}
+
+// ParseRange splits value by sep (e.g. "2024-01-15 .. 2024-01-20" by "..", "2024-01-15,2024-01-16" by ","),
+// and parses each part like Parse
+func (pt *ParseTime) ParseRange(value, sep string) ([]time.Time, error) {
+	if sep == "" {
+		return nil, errInvalidArgs
+	}
+
+	parts := strings.Split(value, sep)
+	times := make([]time.Time, 0, len(parts))
+	for _, part := range parts {
+		t, err := pt.Parse(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		times = append(times, t)
+	}
+
+	return times, nil
+}
@@ -0,0 +1,191 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TestInterval struct {
+	Value      string
+	Start, End time.Time
+}
+
+func TestParseInterval(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	intervals := []TestInterval{
+		{
+			Value: "2024-01-15T00:00:00Z/2024-01-16T00:00:00Z",
+			Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15T00:00:00Z/PT1H",
+			Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 15, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "PT1H30M/2024-01-16T00:00:00Z",
+			Start: time.Date(2024, 1, 15, 22, 30, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15T00:00:00+09:00/P1DT0.5S",
+			Start: time.Date(2024, 1, 14, 15, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 15, 15, 0, 0, 5e8, time.UTC),
+		},
+		{
+			Value: "2024-01-15/P1M",
+			Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-31T00:00:00Z/P1M",
+			Start: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2023-12-15T00:00:00Z/P1M",
+			Start: time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-02-29T00:00:00Z/P1Y",
+			Start: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15T00:00:00Z/P1Y2M3DT4H",
+			Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2025, 3, 18, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "P1Y2M/2024-03-15T00:00:00Z",
+			Start: time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15T00:00:00Z/-PT1H",
+			Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 14, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "-P1D/2024-01-15T00:00:00Z",
+			Start: time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "P1M/2024-03-31T00:00:00Z",
+			Start: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15/P2W",
+			Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "P1W/2024-01-15T00:00:00Z",
+			Start: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, ti := range intervals {
+		start, end, err := p.ParseInterval(ti.Value)
+		assert.Equal(nil, err, "Invalid interval: "+ti.Value)
+		assert.Equal(ti.Start.UnixNano(), start.UnixNano(), "Parse error: "+ti.Value)
+		assert.Equal(ti.End.UnixNano(), end.UnixNano(), "Parse error: "+ti.Value)
+	}
+
+	for _, value := range []string{
+		"2024-01-15T00:00:00Z",
+		"PT1H/PT2H",
+		"2024-01-15T00:00:00Z/PT",
+		"2024-01-15T00:00:00Z/P",
+		"2024-01-15T00:00:00Z/P1X",
+		"2024-01-15T00:00:00Z/P1M1Y",
+		"2024-01-15T00:00:00Z/--PT1H",
+		"-PT1H/+PT1H",
+	} {
+		_, _, err := p.ParseInterval(value)
+		assert.NotEqual(nil, err, "Invalid interval: "+value)
+	}
+}
+
+func TestParseDuration(test *testing.T) {
+	assert := assert.New(test)
+
+	durations := map[string]Duration{
+		"PT1H":           {Time: time.Hour},
+		"-PT1H":          {Time: time.Hour, Negative: true},
+		"+PT1H":          {Time: time.Hour},
+		"-P1D":           {Days: 1, Negative: true},
+		"P1Y2M3DT4H5M6S": {Years: 1, Months: 2, Days: 3, Time: 4*time.Hour + 5*time.Minute + 6*time.Second},
+		"PT0,5S":         {Time: 500 * time.Millisecond},
+		"P2W":            {Days: 14},
+		"P1W2DT1H":       {Days: 9, Time: time.Hour},
+	}
+
+	for value, want := range durations {
+		d, err := ParseDuration(value)
+		assert.Equal(nil, err, "Invalid duration: "+value)
+		assert.Equal(want, d, "Parse error: "+value)
+	}
+
+	for _, value := range []string{"", "P", "-P", "PT", "-PT", "P1H", "--PT1H", "PT-1H", "1H", "P1D1W", "PT1W"} {
+		_, err := ParseDuration(value)
+		assert.Equal(errInvalidDuration, err, "Invalid duration: "+value)
+	}
+
+	t := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	d, _ := ParseDuration("-PT1H")
+	assert.Equal(time.Date(2024, 1, 14, 23, 0, 0, 0, time.UTC), d.AddTo(t), "Parse error")
+
+	d, _ = ParseDuration("-P1D")
+	assert.Equal(time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC), d.AddTo(t), "Parse error")
+
+	d, _ = ParseDuration("P1M")
+	assert.Equal(time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), d.AddTo(t), "Parse error")
+}
+
+func TestParseRange(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times, err := p.ParseRange("2024-01-15 .. 2024-01-20", "..")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal([]time.Time{
+		time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+	}, times, "Parse error")
+
+	times, err = p.ParseRange("2024-01-15T09:00:00Z, Jan 16 2024 10:00:00 UTC, 01/17/2024 11:00 AM", ",")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(3, len(times), "Parse error")
+	for i, t := range []time.Time{
+		time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 17, 11, 0, 0, 0, time.UTC),
+	} {
+		assert.Equal(t.Unix(), times[i].Unix(), "Parse error")
+	}
+
+	times, err = p.ParseRange("2024-01-15", "..")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal([]time.Time{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}, times, "Parse error")
+
+	for _, value := range []string{"2024-01-15 .. garbage", "2024-01-15 .. "} {
+		_, err = p.ParseRange(value, "..")
+		assert.NotEqual(nil, err, "Parse error: "+value)
+	}
+
+	_, err = p.ParseRange("2024-01-15", "")
+	assert.Equal(errInvalidArgs, err, "Invalid arguments")
+}
@@ -0,0 +1,95 @@
+package parsetime
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// reJapanese matches Japanese date/time (2024年1月15日 14時30分, 令和6年1月15日(月) 午後2時30分)
+var reJapanese = regexp.MustCompile(`^(?:(?:(明治|大正|昭和|平成|令和)([0-9]{1,2}|元)|([0-9]{4}))年)?` +
+	`([0-9]{1,2})月([0-9]{1,2})日\s*(?:[(（][月火水木金土日][)）]|[月火水木金土日]曜日?)?\s*` +
+	`(?:(午前|午後)\s*)?(?:([0-9]{1,2})時(?:([0-9]{1,2})分(?:([0-9]{1,2})秒)?)?)?$`)
+
+// japaneseEras is the Gregorian year of the first year (元年) of the Japanese eras
+var japaneseEras = map[string]int{
+	"明治": 1868,
+	"大正": 1912,
+	"昭和": 1926,
+	"平成": 1989,
+	"令和": 2019,
+}
+
+func (pt *ParseTime) parseJapanese(value string) (dateTime, int, error) {
+	var dt dateTime
+	var priority int
+	var err error
+	loc := pt.location
+
+	matched := strings.TrimSpace(value)
+	group := findSubmatch(reJapanese, strings.TrimSpace(toHalfWidth(value)), 9)
+
+	if len(group) == 0 {
+		return dt, priority, errInvalidDateTime
+	}
+
+	var year int
+	switch {
+	case group[1] != "":
+		n := 1
+		if group[2] != "元" {
+			n, _ = strconv.Atoi(group[2])
+		}
+		year = japaneseEras[group[1]] + n - 1
+	default:
+		year, err = pt.dateToInt(group[3], "year", loc)
+		if err != nil {
+			return dt, priority, err
+		}
+	}
+
+	dates := []string{group[4], group[5], group[7], group[8], group[9]}
+	limits := []int{12, 31, 23, 59, 59}
+
+	var fields [5]int
+	for i, dateType := range []string{"month", "day", "hour", "min", "sec"} {
+		fields[i], err = pt.dateToInt(dates[i], dateType, loc)
+		if err != nil {
+			return dt, priority, err
+		}
+		if fields[i] > limits[i] {
+			return dt, priority, errInvalidDateTime
+		}
+	}
+
+	// 午前 (AM), 午後 (PM)
+	hour := fields[2]
+	if group[6] != "" {
+		if hour > 12 {
+			return dt, priority, errInvalidDateTime
+		}
+		hour %= 12
+		if group[6] == "午後" {
+			hour += 12
+		}
+	}
+
+	return dateTime{
+		year:        year,
+		month:       fields[0],
+		day:         fields[1],
+		hour:        hour,
+		min:         fields[3],
+		sec:         fields[4],
+		loc:         loc,
+		yearMissing: group[1] == "" && group[3] == "",
+		matched:     matched,
+	}, priority, nil
+}
+
+// Japanese parses Japanese date/time (e.g. "2024年1月15日 14時30分", "令和6年1月15日(月) 午後2時30分").
+// Full-width digits are accepted, and the year is taken from the clock if it is omitted.
+func (pt *ParseTime) Japanese(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseJapanese, value)
+}
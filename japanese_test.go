@@ -0,0 +1,71 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJapanese(test *testing.T) {
+	assert := assert.New(test)
+
+	jst := time.FixedZone("JST", 9*3600)
+
+	p, _ := NewParseTime(jst)
+	p.SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, jst)))
+
+	times := []TestTime{
+		{
+			Value: "2024年1月15日",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, jst),
+		},
+		{
+			Value: "2024年1月15日14時30分",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, jst),
+		},
+		{
+			Value: "2024年01月15日 14時30分05秒",
+			Time:  time.Date(2024, 1, 15, 14, 30, 5, 0, jst),
+		},
+		{
+			Value: "２０２４年１月１５日　１４時３０分",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, jst),
+		},
+		{
+			Value: "2024年1月15日(月) 午後2時30分",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, jst),
+		},
+		{
+			Value: "2024年1月15日 月曜日 午前12時",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, jst),
+		},
+		{
+			Value: "令和6年1月15日",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, jst),
+		},
+		{
+			Value: "令和元年5月1日",
+			Time:  time.Date(2019, 5, 1, 0, 0, 0, 0, jst),
+		},
+		{
+			Value: "平成31年4月30日",
+			Time:  time.Date(2019, 4, 30, 0, 0, 0, 0, jst),
+		},
+		{
+			Value: "1月15日 9時",
+			Time:  time.Date(2024, 1, 15, 9, 0, 0, 0, jst),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Japanese(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	for _, value := range []string{"", "2024年", "2024年13月1日", "2024年1月32日", "2024年1月15日 24時", "2024年1月15日 午後13時", "2024-01-15"} {
+		_, err := p.Japanese(value)
+		assert.NotEqual(nil, err, "Parse error: "+value)
+	}
+}
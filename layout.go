@@ -0,0 +1,39 @@
+package parsetime
+
+import (
+	"strings"
+	"time"
+)
+
+// AddLayout registers a layout of time.Parse (e.g. "2006年01月02日 15時04分") that Parse tries
+// when none of its formats match the whole date/time string
+func (pt *ParseTime) AddLayout(layout string) {
+	pt.layouts = append(pt.layouts, layout)
+}
+
+func (pt *ParseTime) parseLayouts(value string) (dateTime, error) {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range pt.layouts {
+		t, err := time.ParseInLocation(layout, value, pt.location)
+		if err != nil {
+			continue
+		}
+
+		dt := timeToDateTime(t)
+		dt.format = "Layout"
+		dt.matched = value
+		if layoutHasZone(layout) {
+			dt.offset = t.Format("-07:00")
+		}
+
+		return dt, nil
+	}
+
+	return dateTime{}, errInvalidDateTime
+}
+
+// layoutHasZone reports whether the layout has an offset/timezone element (e.g. "MST", "-07:00", "Z07:00")
+func layoutHasZone(layout string) bool {
+	return strings.Contains(layout, "MST") || strings.Contains(layout, "-07") || strings.Contains(layout, "Z07")
+}
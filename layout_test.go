@@ -0,0 +1,42 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddLayout(test *testing.T) {
+	assert := assert.New(test)
+
+	jst := time.FixedZone("JST", 9*3600)
+	p, _ := NewParseTime(jst)
+
+	value := "2006年01月02日 15時04分"
+
+	result, err := p.ParseDetailed(value)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.NotEqual("", result.Leftover, "Leftover error")
+
+	_, err = p.Parse("x2006y01z02")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+
+	p.AddLayout("x2006y01z02")
+	p.AddLayout("2006年01月02日 15時04分")
+
+	result, err = p.ParseDetailed(value)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 0, 0, jst).Unix(), result.Time.Unix(), "Parse error")
+	assert.Equal("Layout", result.Format, "Format error")
+	assert.Equal("", result.Leftover, "Leftover error")
+
+	t, err := p.Parse("x2006y01z02")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 0, 0, 0, 0, jst).Unix(), t.Unix(), "Parse error")
+
+	// the formats are preferred when they match the whole string
+	result, err = p.ParseDetailed("2006-01-02T15:04:05Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("ISO8601", result.Format, "Format error")
+}
@@ -0,0 +1,33 @@
+package parsetime
+
+import (
+	"errors"
+)
+
+var errLeapSecond = errors.New("Leap second")
+
+// LeapSecondPolicy is how a leap second (e.g. 23:59:60) is resolved
+type LeapSecondPolicy int
+
+const (
+	// LeapSecondNormalize moves the time to the next minute (e.g. 2016-12-31T23:59:60Z becomes 2017-01-01T00:00:00Z), as time.Date does
+	LeapSecondNormalize LeapSecondPolicy = iota
+	// LeapSecondReject returns an error
+	LeapSecondReject
+)
+
+// SetLeapSecondPolicy sets how a leap second is resolved
+func (pt *ParseTime) SetLeapSecondPolicy(policy LeapSecondPolicy) *ParseTime {
+	pt.leapSecondPolicy = policy
+
+	return pt
+}
+
+// resolveLeapSecond applies the leap second policy to the second of the date/time
+func (pt *ParseTime) resolveLeapSecond(sec int) error {
+	if sec == 60 && pt.leapSecondPolicy == LeapSecondReject {
+		return errLeapSecond
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLeapSecondPolicy(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	values := []string{
+		"2016-12-31T23:59:60Z",
+		"2016-12-31T23:59:60.5Z",
+		"Sat, 31 Dec 2016 23:59:60 GMT",
+		"12/31/2016 11:59:60 PM",
+	}
+
+	for _, value := range values {
+		t, err := p.Parse(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error: "+value)
+	}
+
+	p.SetLeapSecondPolicy(LeapSecondReject)
+
+	for _, value := range values {
+		_, err := p.Parse(value)
+		assert.Equal(errLeapSecond, err, "Leap second: "+value)
+	}
+
+	t, err := p.Parse("2016-12-31T23:59:59Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
@@ -0,0 +1,65 @@
+package parsetime
+
+import (
+	"regexp"
+	"strings"
+)
+
+// reOffsetToken matches a numeric offset separated by spaces (-07:00, +0900)
+var reOffsetToken = regexp.MustCompile(`(?:^|\s)([+-](?:0[0-9]|1[0-4]):?[0-5][0-9])(?:\s|$)`)
+
+// SetLenient sets whether date/time strings are parsed leniently.
+// In lenient mode, a numeric offset anywhere in the string (e.g. "-07:00 2024-01-15T14:30:00") is applied to the rest of the string
+// when no format matches the whole string, and a trailing comment (e.g. "(UTC)") is removed before parsing.
+// The comment is used as the timezone when the rest of the string has no offset/timezone.
+func (pt *ParseTime) SetLenient(lenient bool) *ParseTime {
+	pt.lenient = lenient
+
+	return pt
+}
+
+// parseOffsetAnywhere extracts a numeric offset from value, parses the rest and applies the offset to it
+func (pt *ParseTime) parseOffsetAnywhere(value string) (dateTime, error) {
+	var dt dateTime
+
+	index := reOffsetToken.FindStringSubmatchIndex(value)
+	if index == nil {
+		return dt, errInvalidDateTime
+	}
+
+	token := value[index[2]:index[3]]
+	rest := strings.TrimSpace(value[:index[2]] + " " + value[index[3]:])
+
+	p := *pt
+	p.lenient = false
+	dt, err := p.parse(rest)
+	if err != nil {
+		return dt, err
+	}
+
+	// the rest must be fully parsed without offset/timezone
+	if dt.priority > 0 || dt.zone() != "" {
+		return dt, errInvalidDateTime
+	}
+
+	dt.loc, err = pt.toLocation(token)
+	if err != nil {
+		return dt, err
+	}
+
+	dt.offset = token
+	dt.matched = strings.TrimSpace(value)
+
+	return dt, nil
+}
+
+// splitTrailingComment splits a trailing parenthesized comment from value,
+// and returns the rest, the comment and the removed suffix (e.g. "2024-01-15 (UTC)" -> "2024-01-15", "UTC", " (UTC)")
+func splitTrailingComment(value string) (string, string, string) {
+	index := reTrailingComment.FindStringSubmatchIndex(value)
+	if index == nil {
+		return value, "", ""
+	}
+
+	return value[:index[0]], strings.TrimSpace(value[index[2]:index[3]]), value[index[0]:]
+}
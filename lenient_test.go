@@ -0,0 +1,98 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLenientOffsetFirst(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	r, err := p.ParseDetailed("-07:00 2024-01-15T14:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.NotEqual("", r.Leftover, "Parse error")
+
+	p.SetLenient(true)
+
+	times := []TestTime{
+		{Value: "-07:00 2024-01-15T14:30:00", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", -7*3600))},
+		{Value: "+0900 2024-01-15 14:30:00", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600))},
+		{Value: "2024-01-15 +09:00 14:30:00", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600))},
+		{Value: "+09:00 01/15/2024 2:30 PM", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600))},
+	}
+
+	for _, tt := range times {
+		r, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), r.Time.Unix(), "Parse error: "+tt.Value)
+		assert.Equal(true, r.ExplicitZone, "Parse error: "+tt.Value)
+		assert.Equal("", r.Leftover, "Leftover error: "+tt.Value)
+	}
+
+	// the offset is not applied to the rest with an offset
+	r, err = p.ParseDetailed("-07:00 2024-01-15T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.NotEqual("", r.Leftover, "Parse error")
+	// offsets are up to 14:59
+	_, err = p.parseOffsetAnywhere("+20:00 2024-01-15T14:30:00")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+}
+
+func TestSetLenientTrailingComment(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	r, err := p.ParseDetailed("2024-01-15T14:30:00Z (UTC)")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("(UTC)", r.Leftover, "Leftover error")
+
+	p.SetLenient(true)
+
+	times := []struct {
+		Value  string
+		Time   time.Time
+		Format string
+	}{
+		{
+			Value:  "2024-01-15T14:30:00Z (UTC)",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+			Format: "ISO8601",
+		},
+		{
+			// the comment is the timezone when there is no offset/timezone
+			Value:  "2024-01-15T14:30:00 (JST)",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("JST", 9*3600)),
+			Format: "ISO8601",
+		},
+		{
+			Value:  "Mon, 15 Jan 2024 14:30:00 +0000 (UTC)",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+			Format: "RFC8xx1123",
+		},
+		{
+			Value:  "01/15/2024 2:30 PM (UTC)",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+			Format: "US",
+		},
+		{
+			// the offset is used rather than the comment
+			Value:  "2024-01-15T14:30:00+09:00 (UTC)",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600)),
+			Format: "ISO8601",
+		},
+	}
+
+	for _, tt := range times {
+		r, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), r.Time.Unix(), "Parse error: "+tt.Value)
+		assert.Equal(tt.Format, r.Format, "Parse error: "+tt.Value)
+		assert.Equal(true, r.ExplicitZone, "Parse error: "+tt.Value)
+		assert.Equal("", r.Leftover, "Leftover error: "+tt.Value)
+	}
+}
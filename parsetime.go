@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
@@ -18,36 +19,238 @@ var (
 	errInvalidOffset   = errors.New("Invalid offset")
 	errInvalidArgs     = errors.New("Invalid arguments")
 	errInvalidTimezone = errors.New("Invalid timezone")
+	errUnknownFormat   = errors.New("Unknown format")
+	errNoLocation      = errors.New("No matching location")
+	errUnknownZone     = errors.New("Unknown timezone")
+	errInvalidDate     = errors.New("Invalid date")
+	errOffsetRange     = errors.New("Offset out of range")
+	errInvalidWeek     = errors.New("Invalid week")
 	reISO8601          = regexp.MustCompile(ISO8601)
 	reRFC8xx1123       = regexp.MustCompile(RFC8xx1123)
 	reANSIC            = regexp.MustCompile(ANSIC)
 	reUS               = regexp.MustCompile(US)
+	reWordyOffset      = regexp.MustCompile(`(?i)(?:UTC|GMT)\s*(plus|minus)\s*([0-9]{1,2})(?::([0-9]{2}))?`)
+	reWordyOffsetHours = regexp.MustCompile(`(?i)([0-9]{1,2})(?::([0-9]{2}))?\s*hours?\s*(ahead of|behind)\s*(?:UTC|GMT)`)
+	reTrailingComment  = regexp.MustCompile(`\s*\(([^()]*)\)\s*$`)
+	reDecimalOffset    = regexp.MustCompile(`(^|[^.])([+-])([0-9]{1,2})[.]([0-9]{1,2})\b`)
+	reISONoYear        = regexp.MustCompile(`^--(1[012]|0[1-9])-?(3[01]|[12][0-9]|0[1-9])([^0-9]|$)`)
+	reISOMonth         = regexp.MustCompile(`^[0-9]{4}-(1[012]|0[1-9])$`)
+	reISOYear          = regexp.MustCompile(`^[0-9]{4}$`)
+	reISOExpandedYear  = regexp.MustCompile(`^[+]([0-9]{4,6})([^0-9]|$)`)
+	reANSICYear        = regexp.MustCompile(`[0-9]:[0-9]{1,2}\s+` + year + `\s*$`)
+	reLeadingOffset    = regexp.MustCompile(`^[+-](?:0[0-9]|1[0-4]):?[0-9]{2}`)
+	reOffsetRange      = regexp.MustCompile(`^[+-](?:0[0-9]|1[0-4]):?[0-5][0-9]$`)
+	reISOWeekDate      = regexp.MustCompile(`^([0-9]{4})-?W(5[0-3]|[0-4][0-9])(?:-?([1-7]))?([^0-9]|$)`)
 )
 
+// dateTime holds the date/time components matched by a parser
+type dateTime struct {
+	year, month, day     int
+	hour, min, sec, nsec int
+	loc                  *time.Location
+	offset, abbr         string
+	// extraOffset is a second numeric offset following offset, which is checked in strict mode
+	extraOffset string
+
+	// yearMissing reports whether the input had a month but no year
+	yearMissing bool
+	// weekday is the leading weekday name in the input validated by SetValidateWeekday
+	weekday *time.Weekday
+
+	// matched is the part of the input matched by the parser
+	matched string
+	// format is the name of the format that matched and priority its rank in Parse
+	format   string
+	priority int
+}
+
+// zone returns the offset/zone tokens as they appeared in the input
+func (dt dateTime) zone() string {
+	return strings.TrimSpace(dt.offset + " " + dt.abbr)
+}
+
+type parseFunc func(pt *ParseTime, value string) (dateTime, int, error)
+
+// format is a named date/time parser tried by Parse.
+// weight is the specificity of the format, the more specific the smaller.
+type format struct {
+	name   string
+	parse  parseFunc
+	weight int
+}
+
+var formats = []format{
+	{name: "ISO8601", parse: (*ParseTime).parseISO8601, weight: 0},
+	{name: "RFC8xx1123", parse: (*ParseTime).parseRFC8xx1123, weight: 11},
+	{name: "ANSIC", parse: (*ParseTime).parseANSIC, weight: 13},
+	{name: "US", parse: (*ParseTime).parseUS, weight: 15},
+	{name: "Week", parse: (*ParseTime).parseWeek, weight: 2},
+	{name: "TimeString", parse: (*ParseTime).parseTimeString, weight: 5},
+	{name: "Epoch", parse: (*ParseTime).parseEpoch, weight: 0},
+}
+
+func lookupFormat(name string) (format, bool) {
+	for _, f := range formats {
+		if f.name == name {
+			return f, true
+		}
+	}
+
+	return format{}, false
+}
+
+// IsSupportedFormat reports whether name is the name of a format of SetEnabledFormats and ParseHint
+// ("ISO8601", "RFC8xx1123", "ANSIC", "US", "Week", "TimeString" or "Epoch")
+func IsSupportedFormat(name string) bool {
+	_, ok := lookupFormat(name)
+	return ok
+}
+
+// SetEnabledFormats sets the names of the formats tried by Parse (see IsSupportedFormat for the names).
+// Unknown names are ignored, and all formats are tried if no names are given.
+func (pt *ParseTime) SetEnabledFormats(names ...string) *ParseTime {
+	if len(names) == 0 {
+		pt.enabledFormats = nil
+		return pt
+	}
+
+	pt.enabledFormats = append([]string{}, names...)
+
+	return pt
+}
+
+// SetFormatWeight sets the weight of the format tried by Parse, the more specific the smaller.
+// Parse picks the result with the smallest number of unparsed characters times 10 plus the weight of the format.
+// The default weights are 0 for ISO8601 and Epoch, 2 for Week, 5 for TimeString, 11 for RFC8xx1123, 13 for ANSIC and 15 for US.
+func (pt *ParseTime) SetFormatWeight(name string, weight int) *ParseTime {
+	weights := make(map[string]int, len(pt.formatWeights)+1)
+	for n, w := range pt.formatWeights {
+		weights[n] = w
+	}
+	weights[name] = weight
+
+	pt.formatWeights = weights
+
+	return pt
+}
+
+func (pt *ParseTime) formatWeight(f format) int {
+	if weight, ok := pt.formatWeights[f.name]; ok {
+		return weight
+	}
+
+	return f.weight
+}
+
+// formatOrder returns the formats in the order they are tried, the order of SetEnabledFormats for ParseOrdered
+func (pt *ParseTime) formatOrder() []format {
+	if !pt.ordered || pt.enabledFormats == nil {
+		return formats
+	}
+
+	ordered := make([]format, 0, len(pt.enabledFormats))
+	for _, name := range pt.enabledFormats {
+		if f, ok := lookupFormat(name); ok {
+			ordered = append(ordered, f)
+		}
+	}
+
+	return ordered
+}
+
+func (pt *ParseTime) isEnabledFormat(name string) bool {
+	if pt.enabledFormats == nil {
+		return true
+	}
+
+	for _, enabled := range pt.enabledFormats {
+		if enabled == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// weightScale is the weight of an unparsed character
+const weightScale = 10
+
 type sortedTime struct {
-	time     time.Time
+	dt       dateTime
 	priority int
+	weight   int
+}
+
+func (st sortedTime) rank() int {
+	return st.priority*weightScale + st.weight
 }
 
 type sortedTimes []sortedTime
 
 func (st sortedTimes) Len() int           { return len(st) }
 func (st sortedTimes) Swap(i, j int)      { st[i], st[j] = st[j], st[i] }
-func (st sortedTimes) Less(i, j int) bool { return st[i].priority < st[j].priority }
+func (st sortedTimes) Less(i, j int) bool { return st[i].rank() < st[j].rank() }
 
-// ParseTime parses the date/time string
+// ParseTime parses the date/time string.
+// The SetXxx methods except SetLocation return the receiver, so they can be chained (WithLocation is the chainable SetLocation).
 type ParseTime struct {
 	location *time.Location
+	calendar Calendar
+	clock    Clock
+
+	dstGapPolicy          DSTGapPolicy
+	dstOverlapPolicy      DSTOverlapPolicy
+	yearInferencePolicy   YearInferencePolicy
+	unknownZonePolicy     UnknownZonePolicy
+	leapSecondPolicy      LeapSecondPolicy
+	weekNumbering         WeekNumbering
+	weekStart             time.Weekday
+	numericInterpretation NumericInterpretation
+
+	// enabledFormats is the names of the formats tried by Parse, all formats if nil
+	enabledFormats []string
+	// layouts is the layouts of time.Parse tried by Parse when no format matches the whole string
+	layouts []string
+	// formatWeights is the weights of the formats set by SetFormatWeight
+	formatWeights map[string]int
+
+	stripDigitGrouping  bool
+	hexEpoch            bool
+	wordyOffsets        bool
+	decimalOffsets      bool
+	normalizeWhitespace bool
+	normalizeFullWidth  bool
+	discardInputZone    bool
+	rejectFuture        bool
+	requireTimezone     bool
+	strict              bool
+	lenient             bool
+	blankIsZero         bool
+	isoYearPrecision    bool
+	rejectTwoDigitYear  bool
+	validateWeekday     bool
+	// ordered is set by ParseOrdered
+	ordered bool
+	// components is set by ParseComponents, which returns the months and days out of range
+	components bool
+
+	// validMin and validMax are the range of parsed times, unbounded if zero
+	validMin, validMax time.Time
+	// holidays is the dates that are not business days for ParseBusinessDay
+	holidays []time.Time
+	// weekdayNames is the weekday names for SetValidateWeekday, Weekdays if nil
+	weekdayNames map[string]time.Weekday
 }
 
 // NewParseTime returns a new parser
 func NewParseTime(location ...interface{}) (ParseTime, error) {
 	var loc *time.Location
 	var err error
+	clock := realClock{}
 
 	switch len(location) {
 	case 0:
-		zone, offset := time.Now().In(time.Local).Zone()
+		zone, offset := clock.Now().In(time.Local).Zone()
 		loc = time.FixedZone(zone, offset)
 	case 1:
 		switch val := location[0].(type) {
@@ -55,17 +258,17 @@ func NewParseTime(location ...interface{}) (ParseTime, error) {
 			loc = val
 		case string:
 			if val == "" {
-				zone, offset := time.Now().In(time.Local).Zone()
+				zone, offset := clock.Now().In(time.Local).Zone()
 				loc = time.FixedZone(zone, offset)
 			} else {
 				loc, err = time.LoadLocation(val)
 				if err != nil {
-					tz := timezone.New()
-					tzAbbrInfo, err := tz.GetTzAbbreviationInfo(val)
-					if err != nil && !(isRFC2822Abbrs(val)) {
+					var offset int
+					offset, err = abbrOffset(val)
+					if err != nil {
 						return ParseTime{}, err
 					}
-					loc = time.FixedZone(val, tzAbbrInfo[0].Offset())
+					loc = time.FixedZone(val, offset)
 				}
 			}
 		default:
@@ -79,6 +282,7 @@ func NewParseTime(location ...interface{}) (ParseTime, error) {
 
 	return ParseTime{
 		location: loc,
+		clock:    clock,
 	}, err
 }
 
@@ -92,53 +296,308 @@ func (pt *ParseTime) SetLocation(loc *time.Location) {
 	pt.location = loc
 }
 
+// WithLocation sets *time.Location like SetLocation, and returns the receiver for chaining
+func (pt *ParseTime) WithLocation(loc *time.Location) *ParseTime {
+	pt.SetLocation(loc)
+
+	return pt
+}
+
+// SetClock sets the Clock used to fill in missing date/time fields
+func (pt *ParseTime) SetClock(clock Clock) *ParseTime {
+	pt.clock = clock
+
+	return pt
+}
+
+func (pt *ParseTime) now() time.Time {
+	if pt.clock == nil {
+		return time.Now()
+	}
+
+	return pt.clock.Now()
+}
+
+// SetCalendar sets the calendar used to interpret dates
+func (pt *ParseTime) SetCalendar(calendar Calendar) *ParseTime {
+	pt.calendar = calendar
+
+	return pt
+}
+
+// date returns the year, month and day of dt passed to time.Date, with the inferred year and in the Gregorian calendar
+func (pt *ParseTime) date(dt dateTime) (int, int, int) {
+	if dt.yearMissing {
+		dt.year = pt.inferYear(dt)
+	}
+
+	year, month, day := dt.year, dt.month, dt.day
+
+	if pt.calendar == Julian && isBeforeGregorianReform(year, month, day) {
+		year, month, day = julianToGregorian(year, month, day)
+	}
+
+	return year, month, day
+}
+
+func (pt *ParseTime) toTime(dt dateTime) (time.Time, error) {
+	if err := pt.checkTimezone(dt); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := pt.resolveLeapSecond(dt.sec); err != nil {
+		return time.Time{}, err
+	}
+
+	year, month, day := pt.date(dt)
+
+	t := time.Date(year, time.Month(month), day, dt.hour, dt.min, dt.sec, dt.nsec, dt.loc)
+	if err := pt.checkZoneAgreement(dt, t); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := pt.checkWeekday(dt, t); err != nil {
+		return time.Time{}, err
+	}
+	want := time.Date(year, time.Month(month), day, dt.hour, dt.min, dt.sec, dt.nsec, time.UTC)
+
+	t, err := pt.resolveDSTGap(t, want)
+	if err != nil {
+		return t, err
+	}
+
+	t = pt.resolveDSTOverlap(t, want)
+
+	if pt.discardInputZone {
+		t = t.In(pt.location)
+	}
+
+	if err := pt.validate(t); err != nil {
+		return time.Time{}, err
+	}
+
+	return t, nil
+}
+
+// SetKeepInputZone sets whether the result keeps the offset/timezone of the input (default true),
+// or is converted to the parser's location
+func (pt *ParseTime) SetKeepInputZone(keep bool) *ParseTime {
+	pt.discardInputZone = !keep
+
+	return pt
+}
+
 func fixedZone(t time.Time) *time.Location {
 	zone, offset := t.Zone()
 	return time.FixedZone(zone, offset)
 }
 
+// ParseLocation parses the offset/timezone string (e.g. "Z", "-07:00", "-0700", "PST") to *time.Location.
+// "Z", "UTC", "GMT" and "Zulu" (case-insensitive) are time.UTC.
+func ParseLocation(value string) (*time.Location, error) {
+	switch strings.ToUpper(value) {
+	case "Z", "UTC", "GMT", "ZULU":
+		return time.UTC, nil
+	}
+
+	return parseOffset(value)
+}
+
 func parseOffset(value string) (*time.Location, error) {
 	var err error
 	var t time.Time
 	var loc *time.Location
 
+	// offsets are up to 14:59 (+15:00, +09:60)
 	t, err = time.Parse("-07:00", value)
 	if err == nil {
+		if !reOffsetRange.MatchString(value) {
+			return loc, errOffsetRange
+		}
 		return fixedZone(t), nil
 	}
 
 	t, err = time.Parse("-0700", value)
 	if err == nil {
+		if !reOffsetRange.MatchString(value) {
+			return loc, errOffsetRange
+		}
 		return fixedZone(t), nil
 	}
 
 	_, err = time.Parse("MST", value)
 	if err == nil {
-		tz := timezone.New()
-		tzAbbrInfo, err := tz.GetTzAbbreviationInfo(value)
-		if err != nil && !(isRFC2822Abbrs(value)) {
+		offset, err := abbrOffset(value)
+		if err != nil {
 			return loc, err
 		}
 
-		return time.FixedZone(value, tzAbbrInfo[0].Offset()), nil
+		return time.FixedZone(value, offset), nil
 	}
 
 	return loc, errInvalidOffset
 }
 
-func toLocation(offset string) (*time.Location, error) {
-	var err error
-	var loc *time.Location
+// SetWordyOffsets sets whether offsets written in words ("UTC minus 5", "5 hours behind UTC") are recognized
+func (pt *ParseTime) SetWordyOffsets(wordy bool) *ParseTime {
+	pt.wordyOffsets = wordy
 
-	if strings.ToUpper(offset) == "Z" {
-		loc = time.UTC
+	return pt
+}
+
+// parseWordyOffset converts an offset written in words to a numeric offset (e.g. "UTC plus 5:30" -> "+05:30")
+func parseWordyOffset(value string) (string, error) {
+	var sign, hours, minutes string
+
+	if group := reWordyOffset.FindStringSubmatch(value); len(group) != 0 {
+		sign, hours, minutes = group[1], group[2], group[3]
+	} else if group := reWordyOffsetHours.FindStringSubmatch(value); len(group) != 0 {
+		hours, minutes, sign = group[1], group[2], group[3]
 	} else {
-		loc, err = parseOffset(offset)
+		return "", errInvalidOffset
+	}
+
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return "", err
+	}
+
+	m := 0
+	if minutes != "" {
+		m, err = strconv.Atoi(minutes)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	switch strings.ToLower(sign) {
+	case "minus", "behind":
+		sign = "-"
+	default:
+		sign = "+"
+	}
+
+	return fmt.Sprintf("%s%02d:%02d", sign, h, m), nil
+}
+
+func replaceWordyOffset(value string) string {
+	replace := func(words string) string {
+		offset, err := parseWordyOffset(words)
+		if err != nil {
+			return words
+		}
+
+		return offset
+	}
+
+	value = reWordyOffset.ReplaceAllStringFunc(value, replace)
+	return reWordyOffsetHours.ReplaceAllStringFunc(value, replace)
+}
+
+// UnknownZonePolicy is how a timezone abbreviation that can not be resolved is handled
+type UnknownZonePolicy int
+
+const (
+	// UnknownZoneIgnore leaves the abbreviation unparsed, so that the format fails to match or matches with unparsed text
+	UnknownZoneIgnore UnknownZonePolicy = iota
+	// UnknownZoneError returns an error, also from Parse
+	UnknownZoneError
+	// UnknownZoneUTC uses UTC
+	UnknownZoneUTC
+	// UnknownZoneLocal uses the parser's location
+	UnknownZoneLocal
+)
+
+// SetUnknownZonePolicy sets how a timezone abbreviation that can not be resolved is handled (default UnknownZoneIgnore)
+func (pt *ParseTime) SetUnknownZonePolicy(policy UnknownZonePolicy) *ParseTime {
+	pt.unknownZonePolicy = policy
+
+	return pt
+}
+
+// SetDecimalOffsets sets whether offsets in decimal hours ("+5.5", "-3.75") are recognized
+func (pt *ParseTime) SetDecimalOffsets(decimal bool) *ParseTime {
+	pt.decimalOffsets = decimal
+
+	return pt
+}
+
+// parseDecimalOffset converts an offset in decimal hours to a numeric offset (e.g. "+5.5" -> "+05:30")
+func parseDecimalOffset(value string) (string, error) {
+	group := reDecimalOffset.FindStringSubmatch(value)
+	if len(group) == 0 {
+		return "", errInvalidOffset
+	}
+
+	hours, err := strconv.ParseFloat(group[3]+"."+group[4], 64)
+	if err != nil {
+		return "", err
+	}
+
+	minutes := int(hours*60 + 0.5)
+
+	return fmt.Sprintf("%s%02d:%02d", group[2], minutes/60, minutes%60), nil
+}
+
+func replaceDecimalOffset(value string) string {
+	return reDecimalOffset.ReplaceAllStringFunc(value, func(decimal string) string {
+		offset, err := parseDecimalOffset(decimal)
+		if err != nil {
+			return decimal
+		}
+
+		// keep the character before the offset
+		return reDecimalOffset.FindStringSubmatch(decimal)[1] + offset
+	})
+}
+
+func (pt *ParseTime) toLocation(offset string) (*time.Location, error) {
+	loc, err := ParseLocation(offset)
+	if err == nil || isOffset(offset) {
+		return loc, err
+	}
+
+	if loc, ok := lookupZoneName(offset); ok {
+		return loc, nil
+	}
+
+	switch pt.unknownZonePolicy {
+	case UnknownZoneUTC:
+		return time.UTC, nil
+	case UnknownZoneLocal:
+		return pt.location, nil
+	case UnknownZoneError:
+		return loc, errUnknownZone
 	}
 
 	return loc, err
 }
 
+func isOffset(value string) bool {
+	return strings.ToUpper(value) == "Z" || strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-")
+}
+
+// splitZone separates the matched zone tokens into a numeric offset and an abbreviation
+func splitZone(tokens ...string) (string, string) {
+	var offset, abbr string
+
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+
+		if isOffset(token) {
+			if offset == "" {
+				offset = token
+			}
+		} else if abbr == "" {
+			abbr = token
+		}
+	}
+
+	return offset, abbr
+}
+
 func twoDigitTo4DigitYear(year string) (int, error) {
 	val, err := strconv.Atoi(year)
 	if err != nil {
@@ -152,46 +611,49 @@ func twoDigitTo4DigitYear(year string) (int, error) {
 	return 2000 + val, err
 }
 
-func dateToInt(date string, dateType string, loc *time.Location) (int, error) {
+// dateToInt converts the matched date/time component to int.
+// A missing year, month or day is taken from the clock, and a missing hour, minute, second or nanosecond is 0.
+func (pt *ParseTime) dateToInt(date string, dateType string, loc *time.Location) (int, error) {
 	var err error
 	var val int
+	now := pt.now().In(loc)
 
 	if date == "" {
 		switch dateType {
 		case "year":
-			val = time.Now().In(loc).Year()
+			val = now.Year()
 		case "month":
-			val = int(time.Now().In(loc).Month())
+			val = int(now.Month())
 		case "day":
-			val = time.Now().In(loc).Day()
-		case "hour":
-			val = time.Now().In(loc).Hour()
-		case "min":
-			val = time.Now().In(loc).Minute()
-		case "sec":
-			if date == "" {
-				val = 0
-			} else {
-				val = time.Now().In(loc).Second()
-			}
-		case "nsec":
-			if date == "" {
-				val = 0
-			} else {
-				val = time.Now().In(loc).Nanosecond()
-			}
+			val = now.Day()
+		case "hour", "min", "sec", "nsec":
+			val = 0
 		default:
 			err = errInvalidDateTime
 		}
 	} else {
 		switch dateType {
 		case "year":
+			// '06
+			date = strings.TrimPrefix(date, "'")
 			if stringLen(date) == 2 {
+				if pt.rejectTwoDigitYear {
+					return val, errTwoDigitYear
+				}
 				return twoDigitTo4DigitYear(date)
 			}
+		case "nsec":
+			// fractional seconds (".25" -> 250000000)
+			return fractionToNsec(date)
 		case "month":
-			if _, ok := Months[date]; ok {
-				return Months[date], nil
+			registryMu.RLock()
+			month, ok := monthAbbreviations[date]
+			registryMu.RUnlock()
+			if ok {
+				return month, nil
+			}
+			if month, ok := Months[date]; ok {
+				return month, nil
 			}
 		}
 
@@ -202,6 +664,41 @@ func dateToInt(date string, dateType string, loc *time.Location) (int, error) {
 	return val, err
 }
 
+// findSubmatch returns the submatches of re in value like regexp.Regexp.FindStringSubmatch.
+// It also returns nil if re does not have the n groups that the parser reads,
+// so that a regular expression changed without updating its parser fails to match instead of indexing out of range.
+func findSubmatch(re *regexp.Regexp, value string, n int) []string {
+	if re.NumSubexp() != n {
+		return nil
+	}
+
+	return re.FindStringSubmatch(value)
+}
+
+// hasDateTime reports whether any date/time field was matched
+func hasDateTime(fields ...string) bool {
+	for _, field := range fields {
+		if field != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fractionToNsec converts the digits of fractional seconds to nanoseconds (e.g. "5" is 500000000)
+func fractionToNsec(fraction string) (int, error) {
+	if fraction == "" {
+		return 0, nil
+	}
+
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+
+	return strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+}
+
 func isOnlyDate(year, month, day, hour, min string) bool {
 	return year != "" && month != "" && day != "" && hour == "" && min == ""
 }
@@ -210,49 +707,273 @@ func stringLen(value string) int {
 	return utf8.RuneCountInString(strings.Join(strings.Fields(value), ""))
 }
 
+func isPM(ampm string) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	for _, marker := range pmMarkers {
+		if strings.EqualFold(ampm, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func to24Hour(ampm string, value int) int {
-	if strings.ToUpper(ampm) == "PM" {
-		return 12 + value
+	// 12 AM is midnight and 12 PM is noon
+	if isPM(ampm) {
+		return value%12 + 12
 	}
 
-	return value
+	return value % 12
 }
 
-func parseISO8601(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
+// registryMu guards the AM/PM markers, the month abbreviations, the long timezone names
+// and the regular expressions built from them, which are replaced by the Register functions
+var registryMu sync.RWMutex
+
+// registered returns the regular expression that the Register functions may replace
+func registered(re **regexp.Regexp) *regexp.Regexp {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	return *re
+}
+
+// RegisterAMPM registers additional AM/PM markers (e.g. "午前", "午後") for the US parser.
+// It is safe to call concurrently with parsing.
+func RegisterAMPM(am, pm string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	amMarkers = append(amMarkers, am)
+	pmMarkers = append(pmMarkers, pm)
+
+	US = usPattern()
+	reUS = regexp.MustCompile(US)
+	reHourAMPM = regexp.MustCompile(hourAMPMPattern())
+	reRelativeTime = regexp.MustCompile(relativeTimePattern())
+}
+
+// RegisterMonthAbbreviations registers additional month names (e.g. "Sept": 9, "juil.": 7),
+// which are matched before the names of Months by the ISO8601, RFC8xx1123, ANSIC and US parsers.
+// It is safe to call concurrently with parsing.
+func RegisterMonthAbbreviations(months map[string]int) error {
+	for name, month := range months {
+		if name == "" || month < 1 || month > 12 {
+			return errInvalidArgs
+		}
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	for name, month := range months {
+		monthAbbreviations[name] = month
+	}
+
+	ISO8601 = iso8601Pattern()
+	RFC8xx1123 = rfc8xx1123Pattern()
+	ANSIC = ansicPattern()
+	US = usPattern()
+	reISO8601 = regexp.MustCompile(ISO8601)
+	reRFC8xx1123 = regexp.MustCompile(RFC8xx1123)
+	reANSIC = regexp.MustCompile(ANSIC)
+	reUS = regexp.MustCompile(US)
+
+	return nil
+}
+
+// SetISOYearPrecision sets whether 4 digits (2024) are ISO8601 year precision (2024-01-01) instead of time (20:24).
+// Numeric values of 8 or more digits are still compact dates or Unix time.
+func (pt *ParseTime) SetISOYearPrecision(year bool) *ParseTime {
+	pt.isoYearPrecision = year
+
+	return pt
+}
+
+// expandISODate completes the ISO8601 date without year (--01-15) with the year of the clock,
+// and the date of month precision (2024-01) or year precision (2024) with the first day of the month or year.
+// Expanded years (+002024) and week dates (2024-W03-1, 2024-W03) are rewritten as calendar dates.
+// It also returns whether the year was omitted, and errInvalidWeek for a week that the year does not have.
+func (pt *ParseTime) expandISODate(value string) (string, bool, error) {
+	value = trimExpandedYear(value)
+
+	date, rest, err := isoWeekDate(value)
+	if err == nil {
+		return date + rest, false, nil
+	} else if err == errInvalidWeek {
+		return value, false, err
+	}
+
+	if group := reISONoYear.FindStringSubmatch(value); len(group) != 0 {
+		year := pt.now().In(pt.location).Year()
+		return fmt.Sprintf("%04d-%s-%s", year, group[1], group[2]) + value[len(group[0])-len(group[3]):], true, nil
+	}
+
+	if reISOMonth.MatchString(value) {
+		return value + "-01", false, nil
+	}
+
+	if pt.isoYearPrecision && reISOYear.MatchString(value) {
+		return value + "-01-01", false, nil
+	}
+
+	return value, false, nil
+}
+
+// trimExpandedYear returns value with the expanded year of four digits (+002024-01-15 -> 2024-01-15).
+// Years that do not fit in four digits are left as they are.
+func trimExpandedYear(value string) string {
+	group := reISOExpandedYear.FindStringSubmatch(value)
+	if len(group) == 0 {
+		return value
+	}
+
+	year, _ := strconv.Atoi(group[1])
+	if year > 9999 {
+		return value
+	}
+
+	return fmt.Sprintf("%04d", year) + value[len(group[0])-len(group[2]):]
+}
+
+// isoWeekDate returns the calendar date of the ISO8601 week date at the start of value (2024-W03-1 -> 2024-01-15) and the rest of value.
+// A week without weekday (2024-W03) is its Monday.
+// It returns errInvalidDateTime if value does not start with a week date, and errInvalidWeek if the year does not have the week.
+func isoWeekDate(value string) (string, string, error) {
+	group := reISOWeekDate.FindStringSubmatch(value)
+	if len(group) == 0 {
+		return "", value, errInvalidDateTime
+	}
+
+	year, _ := strconv.Atoi(group[1])
+	week, _ := strconv.Atoi(group[2])
+	// 1 is Monday and 7 is Sunday
+	n := 1
+	if group[3] != "" {
+		n, _ = strconv.Atoi(group[3])
+	}
+
+	// W53 of a year with 52 weeks
+	if !hasWeek(year, week, WeekNumberingISO) {
+		return "", value, errInvalidWeek
+	}
+
+	y, m, d := weekDate(year, week, time.Weekday(n%7), WeekNumberingISO, time.Monday)
+
+	return fmt.Sprintf("%04d-%02d-%02d", y, m, d), value[len(group[0])-len(group[4]):], nil
+}
+
+func (pt *ParseTime) parseISO8601(value string) (dateTime, int, error) {
+	var dt dateTime
 	var priority int
 	var err error
+	loc := pt.location
+
+	// --01-15, 2024-01, 2024, 2024-W03-1, 2024-W03, +002024-01-15
+	expanded, yearMissing, err := pt.expandISODate(value)
+	if err != nil {
+		return dt, priority, err
+	}
 
-	group := reISO8601.FindStringSubmatch(value)
+	group := findSubmatch(registered(&reISO8601), expanded, 30)
 
 	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
+		return dt, priority, errInvalidDateTime
 	}
 
-	priority = stringLen(value) - stringLen(group[0])
+	priority = stringLen(expanded) - stringLen(group[0])
+	matched := value[:len(group[0])-(len(expanded)-len(value))]
+
+	// the date is matched by one of the alternatives of isoDateSeps,
+	// and years before 1970 are only matched with "-" separators (1582-10-04)
+	mixed := 1 + 3*(len(isoDateSeps)+1)
+	for i := 4; i < mixed; i += 3 {
+		if group[i] != "" {
+			group[1], group[2], group[3] = group[i], group[i+1], group[i+2]
+		}
+	}
+
+	// 2024.01-15, 2024-0115
+	if group[mixed] != "" {
+		return dt, priority, errInvalidDate
+	}
+	group = append(group[:4], group[mixed+4:]...)
+
+	// minutes without a separator are two digits (150405)
+	if group[6] != "" {
+		group[5] = group[6]
+	}
+	group = append(group[:6], group[7:]...)
+
+	// hour without minutes and seconds (2006-01-02T15Z)
+	if group[8] != "" {
+		if !strings.Contains(strings.ToUpper(group[0]), "T"+group[8]) {
+			return dt, priority, errInvalidDateTime
+		}
+		if h, _ := strconv.Atoi(group[8]); h > 23 {
+			return dt, priority, errInvalidDateTime
+		}
+		group[4], group[5] = group[8], "0"
+	}
+	group = append(group[:8], group[9:]...)
+
+	if !hasDateTime(group[1:8]...) {
+		return dt, priority, errInvalidDateTime
+	}
 
 	var year, month, day, hour, min, sec, nsec int
+	offset, abbr := splitZone(group[8], group[9])
+
+	// a second offset (2024-01-15T14:30:00Z+09:00) is partly matched as the zone
+	var extraOffset string
+	if group[8] != "" && isOffset(group[9]) {
+		extraOffset = reLeadingOffset.FindString(expanded[len(group[0])-len(group[9]):])
+	}
 
+	// 2006-01-02 15:04:05 -07:00 MST, 2006-01-02 15:04:05 MST
 	if group[8] != "" {
-		loc, err = toLocation(group[8])
-		if err != nil {
-			return t, priority, err
+		loc, err = pt.toLocation(group[8])
+	} else if group[9] != "" {
+		loc, err = pt.toLocation(group[9])
+
+		// an unknown abbreviation is left unparsed (2006-01-02 15:04:05 FOO)
+		if err != nil && err != errUnknownZone && !isOffset(group[9]) {
+			loc, err = pt.location, nil
+			abbr = ""
+			priority += stringLen(group[9])
+			matched = strings.TrimSpace(strings.TrimSuffix(matched, group[9]))
 		}
 	}
+	if err != nil {
+		return dt, priority, err
+	}
 
-	year, err = dateToInt(group[1], "year", loc)
+	year, err = pt.dateToInt(group[1], "year", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	month, err = dateToInt(group[2], "month", loc)
+	month, err = pt.dateToInt(group[2], "month", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	day, err = dateToInt(group[3], "day", loc)
+	day, err = pt.dateToInt(group[3], "day", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
+	}
+
+	// 2024-13-01, 2024-01-32, 2023-02-29 (--02-29 is allowed, as the year is not known yet)
+	daysYear := year
+	if yearMissing {
+		// a leap year
+		daysYear = 2000
+	}
+	if !pt.components && (month > 12 || day > pt.daysIn(daysYear, month)) {
+		return dt, priority, errInvalidDate
 	}
 
 	// 2006-01-02 -> 2006-01-02T00:00
@@ -261,71 +982,102 @@ func parseISO8601(value string, loc *time.Location) (time.Time, int, error) {
 		group[5] = "0"
 	}
 
-	hour, err = dateToInt(group[4], "hour", loc)
+	hour, err = pt.dateToInt(group[4], "hour", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	min, err = dateToInt(group[5], "min", loc)
+	min, err = pt.dateToInt(group[5], "min", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	sec, err = dateToInt(group[6], "sec", loc)
+	sec, err = pt.dateToInt(group[6], "sec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	nsec, err = dateToInt(group[7], "nsec", loc)
+	nsec, err = pt.dateToInt(group[7], "nsec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return dateTime{
+		year:        year,
+		month:       month,
+		day:         day,
+		hour:        hour,
+		min:         min,
+		sec:         sec,
+		nsec:        nsec,
+		loc:         loc,
+		offset:      offset,
+		abbr:        abbr,
+		extraOffset: extraOffset,
+		yearMissing: yearMissing,
+		matched:     matched,
+	}, priority, err
 }
 
 // ISO8601 parses ISO8601, RFC3339 date/time string
 func (pt *ParseTime) ISO8601(value string) (time.Time, error) {
-	t, _, err := parseISO8601(value, pt.location)
-	return t, err
+	return pt.parseFormat((*ParseTime).parseISO8601, value)
 }
 
 // RFC822, RFC850, RFC1123
-func parseRFC8xx1123(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
+func (pt *ParseTime) parseRFC8xx1123(value string) (dateTime, int, error) {
+	var dt dateTime
 	var priority int
 	var err error
+	loc := pt.location
+
+	// RFC5322 comment (Mon, 02 Jan 2006 15:04:05 -0700 (MST))
+	var comment, commentSuffix string
+	if index := reTrailingComment.FindStringSubmatchIndex(value); index != nil {
+		comment = strings.TrimSpace(value[index[2]:index[3]])
+		commentSuffix = value[index[0]:]
+		value = value[:index[0]]
+	}
 
-	group := reRFC8xx1123.FindStringSubmatch(value)
+	group := findSubmatch(registered(&reRFC8xx1123), value, 8)
 
 	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
+		return dt, priority, errInvalidDateTime
 	}
 
 	priority = stringLen(value) - stringLen(group[0])
 
+	matched := group[0]
+	if commentSuffix != "" && strings.HasSuffix(value, group[0]) {
+		matched += commentSuffix
+	}
+
 	var year, month, day, hour, min, sec, nsec int
+	offset, abbr := splitZone(group[8])
 
 	if group[8] != "" {
-		loc, err = toLocation(group[8])
+		loc, err = pt.toLocation(group[8])
 		if err != nil {
-			return t, priority, err
+			return dt, priority, err
 		}
+	} else if commentLoc, err := parseOffset(comment); err == nil {
+		// the comment is only used when there is no offset
+		loc, abbr = commentLoc, comment
 	}
 
-	day, err = dateToInt(group[1], "day", loc)
+	day, err = pt.dateToInt(group[1], "day", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	month, err = dateToInt(group[2], "month", loc)
+	month, err = pt.dateToInt(group[2], "month", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	year, err = dateToInt(group[3], "year", loc)
+	year, err = pt.dateToInt(group[3], "year", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
 	// 02-Jan-06 -> 02-Jan-06 00:00
@@ -334,136 +1086,175 @@ func parseRFC8xx1123(value string, loc *time.Location) (time.Time, int, error) {
 		group[5] = "0"
 	}
 
-	hour, err = dateToInt(group[4], "hour", loc)
+	hour, err = pt.dateToInt(group[4], "hour", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	min, err = dateToInt(group[5], "min", loc)
+	min, err = pt.dateToInt(group[5], "min", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	sec, err = dateToInt(group[6], "sec", loc)
+	sec, err = pt.dateToInt(group[6], "sec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	nsec, err = dateToInt(group[7], "nsec", loc)
+	nsec, err = pt.dateToInt(group[7], "nsec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return dateTime{
+		year:    year,
+		month:   month,
+		day:     day,
+		hour:    hour,
+		min:     min,
+		sec:     sec,
+		nsec:    nsec,
+		loc:     loc,
+		offset:  offset,
+		abbr:    abbr,
+		matched: matched,
+	}, priority, err
 }
 
 // RFC8xx1123 parses RFC822, RFC850, RFC1123 date/time string
 func (pt *ParseTime) RFC8xx1123(value string) (time.Time, error) {
-	t, _, err := parseRFC8xx1123(value, pt.location)
-	return t, err
+	return pt.parseFormat((*ParseTime).parseRFC8xx1123, value)
 }
 
-func parseANSIC(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
+func (pt *ParseTime) parseANSIC(value string) (dateTime, int, error) {
+	var dt dateTime
 	var err error
 	var priority int
+	loc := pt.location
 
-	group := reANSIC.FindStringSubmatch(value)
+	group := findSubmatch(registered(&reANSIC), value, 8)
 
 	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
+		return dt, priority, errInvalidDateTime
 	}
 
 	priority = stringLen(value) - stringLen(group[0])
 
+	// the year of ctime without seconds (Mon Jan  2 15:04 2006) is matched as seconds and fraction
+	if group[7] == "" && group[8] == "" {
+		if yearGroup := reANSICYear.FindStringSubmatch(group[0]); len(yearGroup) != 0 {
+			group[5], group[6], group[8] = "", "", yearGroup[1]
+		}
+	}
+
 	var year, month, day, hour, min, sec, nsec int
+	offset, abbr := splitZone(group[7])
 
 	if group[7] != "" {
-		loc, err = toLocation(group[7])
+		loc, err = pt.toLocation(group[7])
 		if err != nil {
-			return t, priority, err
+			return dt, priority, err
 		}
 	}
 
-	month, err = dateToInt(group[1], "month", loc)
+	month, err = pt.dateToInt(group[1], "month", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	day, err = dateToInt(group[2], "day", loc)
+	day, err = pt.dateToInt(group[2], "day", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	hour, err = dateToInt(group[3], "hour", loc)
+	hour, err = pt.dateToInt(group[3], "hour", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	min, err = dateToInt(group[4], "min", loc)
+	min, err = pt.dateToInt(group[4], "min", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	sec, err = dateToInt(group[5], "sec", loc)
+	sec, err = pt.dateToInt(group[5], "sec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	nsec, err = dateToInt(group[6], "nsec", loc)
+	nsec, err = pt.dateToInt(group[6], "nsec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	year, err = dateToInt(group[8], "year", loc)
+	year, err = pt.dateToInt(group[8], "year", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return dateTime{
+		year:        year,
+		month:       month,
+		day:         day,
+		hour:        hour,
+		min:         min,
+		sec:         sec,
+		nsec:        nsec,
+		loc:         loc,
+		offset:      offset,
+		abbr:        abbr,
+		yearMissing: group[8] == "",
+		matched:     group[0],
+	}, priority, err
 }
 
-// ANSIC parses ANSIC date/time string
+// ANSIC parses ANSIC date/time string.
+// Seconds may be omitted (Mon Jan  2 15:04 2006).
 func (pt *ParseTime) ANSIC(value string) (time.Time, error) {
-	t, _, err := parseANSIC(value, pt.location)
-	return t, err
+	return pt.parseFormat((*ParseTime).parseANSIC, value)
 }
 
-func parseUS(value string, loc *time.Location) (time.Time, int, error) {
-	var t time.Time
+func (pt *ParseTime) parseUS(value string) (dateTime, int, error) {
+	var dt dateTime
 	var priority int
 	var err error
+	loc := pt.location
 
-	group := reUS.FindStringSubmatch(value)
+	group := findSubmatch(registered(&reUS), value, 9)
 
 	if len(group) == 0 {
-		return t, priority, errInvalidDateTime
+		return dt, priority, errInvalidDateTime
 	}
 
 	priority = stringLen(value) - stringLen(group[0])
 
+	if !hasDateTime(group[1:8]...) {
+		return dt, priority, errInvalidDateTime
+	}
+
 	var year, month, day, hour, min, sec, nsec int
+	offset, abbr := splitZone(group[9])
 
 	if group[9] != "" {
-		loc, err = toLocation(group[9])
+		loc, err = pt.toLocation(group[9])
 		if err != nil {
-			return t, priority, err
+			return dt, priority, err
 		}
 	}
 
-	month, err = dateToInt(group[1], "month", loc)
+	month, err = pt.dateToInt(group[1], "month", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	day, err = dateToInt(group[2], "day", loc)
+	day, err = pt.dateToInt(group[2], "day", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	year, err = dateToInt(group[3], "year", loc)
+	year, err = pt.dateToInt(group[3], "year", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
 	if isOnlyDate(group[1], group[2], group[3], group[4], group[5]) {
@@ -471,24 +1262,24 @@ func parseUS(value string, loc *time.Location) (time.Time, int, error) {
 		group[5] = "0"
 	}
 
-	hour, err = dateToInt(group[4], "hour", loc)
+	hour, err = pt.dateToInt(group[4], "hour", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	min, err = dateToInt(group[5], "min", loc)
+	min, err = pt.dateToInt(group[5], "min", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	sec, err = dateToInt(group[6], "sec", loc)
+	sec, err = pt.dateToInt(group[6], "sec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
-	nsec, err = dateToInt(group[7], "nsec", loc)
+	nsec, err = pt.dateToInt(group[7], "nsec", loc)
 	if err != nil {
-		return t, priority, err
+		return dt, priority, err
 	}
 
 	ampm := group[8]
@@ -497,48 +1288,584 @@ func parseUS(value string, loc *time.Location) (time.Time, int, error) {
 		hour = to24Hour(ampm, hour)
 	}
 
-	return time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc), priority, err
+	return dateTime{
+		year:        year,
+		month:       month,
+		day:         day,
+		hour:        hour,
+		min:         min,
+		sec:         sec,
+		nsec:        nsec,
+		loc:         loc,
+		offset:      offset,
+		abbr:        abbr,
+		yearMissing: group[1] != "" && group[3] == "",
+		matched:     group[0],
+	}, priority, err
 }
 
 // US parses MM/DD/YYYY format date/time string
 func (pt *ParseTime) US(value string) (time.Time, error) {
-	t, _, err := parseUS(value, pt.location)
-	return t, err
+	return pt.parseFormat((*ParseTime).parseUS, value)
 }
 
-// Parse parses date/time string
-func (pt *ParseTime) Parse(value string) (time.Time, error) {
+// isRejection reports whether err is returned by a format that matched the value but rejected it,
+// such as a two-digit year of SetRejectTwoDigitYear, an abbreviation of UnknownZoneError, a day beyond the month,
+// an offset out of range or a week that the year does not have
+func isRejection(err error) bool {
+	switch err {
+	case errTwoDigitYear, errUnknownZone, errInvalidDate, errOffsetRange, errInvalidWeek:
+		return true
+	}
+
+	return false
+}
+
+func (pt *ParseTime) parse(value string) (dateTime, error) {
+	var dt dateTime
 	times := make(sortedTimes, 0)
-	t, priority, _ := parseISO8601(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+
+	if pt.validateWeekday {
+		if rest, prefix, weekday, ok := pt.splitWeekday(value); ok {
+			p := *pt
+			p.validateWeekday = false
+			dt, err := p.parse(rest)
+			dt.matched = prefix + dt.matched
+			dt.weekday = &weekday
+
+			return dt, err
+		}
+	}
+
+	value = pt.prepare(value)
+
+	// ISO8601 wins the canonical RFC3339 form unless the weights or the order are changed
+	if pt.formatWeights == nil && !pt.ordered && pt.isEnabledFormat("ISO8601") {
+		if dt, ok := pt.fastRFC3339(value); ok {
+			return dt, nil
+		}
+	}
+
+	var comment, commentSuffix string
+	if pt.lenient {
+		value, comment, commentSuffix = splitTrailingComment(value)
 	}
 
-	t, priority, _ = parseRFC8xx1123(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+	// Eastern Time
+	value, zoneName, zoneNameSuffix := splitZoneName(value)
+	trimmed := value
+
+	days, prefix, rest, relative := splitRelativeDay(value)
+	if relative {
+		// only a time follows the relative day, as its date is replaced (tomorrow 2024-01-15 10:00)
+		if !registered(&reRelativeTime).MatchString(rest) {
+			return dt, errInvalidDateTime
+		}
+		value = expandHourAMPM(rest)
 	}
 
-	t, priority, _ = parseANSIC(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+	numeric, err := pt.numericFormat(value)
+	if err != nil {
+		return dt, err
 	}
 
-	t, priority, _ = parseUS(value, pt.location)
-	if !t.IsZero() {
-		times = append(times, sortedTime{time: t, priority: priority})
+	// the best match rejected by isRejection
+	var rejected *sortedTime
+	var rejectedErr error
+
+	for _, f := range pt.formatOrder() {
+		// Epoch is only tried for the values that numericFormat detects
+		if !pt.isEnabledFormat(f.name) || (numeric != "" && f.name != numeric) || (numeric == "" && f.name == "Epoch") {
+			continue
+		}
+
+		dt, priority, err := f.parse(pt, value)
+		if err == nil {
+			dt.format = f.name
+			dt.priority = priority
+			times = append(times, sortedTime{dt: dt, priority: priority, weight: pt.formatWeight(f)})
+		} else if isRejection(err) {
+			// the first match wins for ParseOrdered even if it is rejected
+			if pt.ordered {
+				return dt, err
+			}
+
+			st := sortedTime{priority: priority, weight: pt.formatWeight(f)}
+			if rejected == nil || st.rank() < rejected.rank() {
+				rejected = &st
+				rejectedErr = err
+			}
+		}
+
+		if pt.ordered && len(times) > 0 {
+			break
+		}
+	}
+
+	if !pt.ordered {
+		sort.Sort(times)
+	}
+
+	// a match that leaves as much as a rejected one must not win over it
+	// (2024-01-32 is not read as Jan 20, and 01/15/24 of SetRejectTwoDigitYear is not read as 02:04)
+	if rejected != nil && (len(times) == 0 || times[0].priority >= rejected.priority) {
+		return dt, rejectedErr
+	}
+
+	// the layouts and the offset anywhere are tried only when no format matches for ParseOrdered
+	fallback := len(times) == 0 || (!pt.ordered && times[0].dt.priority > 0)
+
+	if fallback {
+		if layoutDt, err := pt.parseLayouts(value); err == nil {
+			times = append(sortedTimes{{dt: layoutDt}}, times...)
+		}
+	}
+
+	if pt.lenient && fallback && (len(times) == 0 || times[0].dt.priority > 0) {
+		if offsetDt, err := pt.parseOffsetAnywhere(value); err == nil {
+			times = append(sortedTimes{{dt: offsetDt}}, times...)
+		}
 	}
 
 	if len(times) == 0 {
-		var tmpT time.Time
-		return tmpT, errInvalidDateTime
+		return dt, errInvalidDateTime
 	}
 
-	sort.Sort(times)
+	dt = times[0].dt
+	if relative {
+		dt = pt.withRelativeDay(dt, days)
+		if value != rest {
+			dt.matched = rest
+		}
+		dt.matched = prefix + dt.matched
+	}
+
+	if zoneNameSuffix != "" {
+		dt = withTrailingZone(dt, trimmed, zoneName, zoneNameSuffix)
+		trimmed += zoneNameSuffix
+	}
+
+	if commentSuffix != "" {
+		dt = withTrailingZone(dt, trimmed, comment, commentSuffix)
+	}
+
+	return dt, nil
+}
+
+// SetNormalizeWhitespace sets whether runs of whitespace (spaces, tabs, newlines) are collapsed to a single space before parsing.
+// Padded days (e.g. "Jan  2") are still parsed.
+func (pt *ParseTime) SetNormalizeWhitespace(normalize bool) *ParseTime {
+	pt.normalizeWhitespace = normalize
+
+	return pt
+}
+
+// SetNormalizeFullWidth sets whether full-width ASCII characters (e.g. "２０２４－０１－１５") and the ideographic space are converted to half-width before parsing
+func (pt *ParseTime) SetNormalizeFullWidth(normalize bool) *ParseTime {
+	pt.normalizeFullWidth = normalize
+
+	return pt
+}
+
+// toHalfWidth converts full-width ASCII characters (U+FF01 to U+FF5E) and the ideographic space (U+3000) to half-width
+func toHalfWidth(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '！' && r <= '～':
+			return r - '！' + '!'
+		case r == '　':
+			return ' '
+		}
 
-	return times[0].time, nil
+		return r
+	}, value)
+}
+
+// prepare rewrites value before it is matched by the parsers
+func (pt *ParseTime) prepare(value string) string {
+	if pt.normalizeFullWidth {
+		value = toHalfWidth(value)
+	}
+
+	if pt.normalizeWhitespace {
+		value = strings.Join(strings.Fields(value), " ")
+	}
+
+	if pt.wordyOffsets {
+		value = replaceWordyOffset(value)
+	}
+
+	if pt.decimalOffsets {
+		value = replaceDecimalOffset(value)
+	}
+
+	if pt.strict {
+		value = replaceISOFraction(value)
+	}
+
+	return value
+}
+
+func (pt *ParseTime) parseFormat(parse parseFunc, value string) (time.Time, error) {
+	dt, _, err := parse(pt, pt.prepare(value))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return pt.toTime(dt)
+}
+
+// ParseResult is the result of ParseDetailed
+type ParseResult struct {
+	// Time is the parsed time
+	Time time.Time
+	// Format is the name of the format that matched (e.g. "ISO8601")
+	Format string
+	// Priority is the number of characters that were not matched by the format
+	Priority int
+	// ExplicitZone reports whether the input had an offset or timezone
+	ExplicitZone bool
+	// Leftover is the text that was not matched by the format
+	Leftover string
+}
+
+// ParseDetailed parses date/time string like Parse, and returns metadata about how it was parsed
+func (pt *ParseTime) ParseDetailed(value string) (ParseResult, error) {
+	if pt.blankIsZero && strings.TrimSpace(value) == "" {
+		return ParseResult{}, nil
+	}
+
+	dt, err := pt.parse(value)
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	t, err := pt.toTime(dt)
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	return ParseResult{
+		Time:         t,
+		Format:       dt.format,
+		Priority:     dt.priority,
+		ExplicitZone: dt.zone() != "",
+		Leftover:     strings.TrimSpace(strings.Replace(pt.prepare(value), dt.matched, "", 1)),
+	}, nil
+}
+
+// SetBlankIsZero sets whether Parse returns the zero time.Time without error for an empty or whitespace-only string
+func (pt *ParseTime) SetBlankIsZero(zero bool) *ParseTime {
+	pt.blankIsZero = zero
+
+	return pt
+}
+
+// Parse parses date/time string
+func (pt *ParseTime) Parse(value string) (time.Time, error) {
+	result, err := pt.ParseDetailed(value)
+	return result.Time, err
+}
+
+// ParseAppend parses each of values like Parse, and appends the results to dst like strconv.AppendInt.
+// It stops at the first value that can not be parsed, and returns dst with the values parsed so far and an error with the index of the value.
+func (pt *ParseTime) ParseAppend(dst []time.Time, values ...string) ([]time.Time, error) {
+	for i, value := range values {
+		t, err := pt.Parse(value)
+		if err != nil {
+			return dst, fmt.Errorf("Value %d: %w", i, err)
+		}
+		dst = append(dst, t)
+	}
+
+	return dst, nil
+}
+
+// ParseOrdered parses date/time string like Parse, but tries the formats in the order of SetEnabledFormats (the default order if not set),
+// and returns the first one that matches regardless of the weights of the formats and the unparsed characters
+func (pt *ParseTime) ParseOrdered(value string) (time.Time, error) {
+	p := *pt
+	p.ordered = true
+
+	return p.Parse(value)
+}
+
+// ParseWithYear parses date/time string like Parse, but uses year when the input has no year (e.g. "Dec 31 23:59:59")
+func (pt *ParseTime) ParseWithYear(value string, year int) (time.Time, error) {
+	dt, err := pt.parse(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if dt.yearMissing {
+		dt.year = year
+		dt.yearMissing = false
+	}
+
+	return pt.toTime(dt)
+}
+
+// ParseComponents parses date/time string like Parse, and returns the fields passed to time.Date without normalization
+// (e.g. "2024-02-30" is day 30 of month 2, not March 1).
+// Unlike Parse, it accepts the ISO8601 months and days up to 99 (e.g. "2024-13-01", "2024-01-32").
+func (pt *ParseTime) ParseComponents(value string) (year, month, day, hour, min, sec, nsec int, loc *time.Location, err error) {
+	p := *pt
+	p.components = true
+
+	dt, err := p.parse(value)
+	if err != nil {
+		return
+	}
+
+	year, month, day = pt.date(dt)
+
+	return year, month, day, dt.hour, dt.min, dt.sec, dt.nsec, dt.loc, nil
+}
+
+// ParseWithFixedZone parses date/time string like Parse, using the fixed zone of zoneName and offsetSeconds instead of the location of the parser
+func (pt *ParseTime) ParseWithFixedZone(value, zoneName string, offsetSeconds int) (time.Time, error) {
+	p := *pt
+	p.location = time.FixedZone(zoneName, offsetSeconds)
+
+	return p.Parse(value)
+}
+
+// ParseLocal parses date/time string like Parse, and converts it to time.Local
+func (pt *ParseTime) ParseLocal(value string) (time.Time, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return t, err
+	}
+
+	return t.In(time.Local), nil
+}
+
+// ParseSince parses date/time string like Parse, and returns the time elapsed since then according to the Clock
+func (pt *ParseTime) ParseSince(value string) (time.Duration, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return pt.now().Sub(t), nil
+}
+
+// ParseToUnix parses date/time string like Parse, and returns Unix time in seconds
+func (pt *ParseTime) ParseToUnix(value string) (int64, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Unix(), nil
+}
+
+// ParseToUnixMilli parses date/time string like Parse, and returns Unix time in milliseconds
+func (pt *ParseTime) ParseToUnixMilli(value string) (int64, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Unix()*1e3 + int64(t.Nanosecond())/1e6, nil
+}
+
+// ParseForColumn parses date/time string like Parse, and truncates it to fractionalDigits (0 to 9) sub-second places,
+// the precision of a database column (e.g. 3 for TIMESTAMP(3))
+func (pt *ParseTime) ParseForColumn(value string, fractionalDigits int) (time.Time, error) {
+	if fractionalDigits < 0 || fractionalDigits > 9 {
+		return time.Time{}, errInvalidArgs
+	}
+
+	t, err := pt.Parse(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	unit := time.Duration(1)
+	for i := fractionalDigits; i < 9; i++ {
+		unit *= 10
+	}
+
+	return t.Truncate(unit), nil
+}
+
+// Normalize parses date/time string like Parse, and returns it in RFC3339 format in UTC.
+// Fractional seconds are only included when they are not zero.
+func (pt *ParseTime) Normalize(value string) (string, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	return t.UTC().Format(time.RFC3339Nano), nil
+}
+
+// ParseDate parses date/time string like Parse, and returns midnight of its date in the parser's location
+func (pt *ParseTime) ParseDate(value string) (time.Time, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return t, err
+	}
+
+	year, month, day := t.In(pt.location).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, pt.location), nil
+}
+
+// ParseHint parses date/time string with only the named format (see IsSupportedFormat for the names)
+func (pt *ParseTime) ParseHint(value, format string) (time.Time, error) {
+	f, ok := lookupFormat(format)
+	if !ok {
+		return time.Time{}, errUnknownFormat
+	}
+
+	return pt.parseFormat(f.parse, value)
+}
+
+// ParseWithOffsetToken parses date/time string like Parse,
+// and also returns the offset/zone token as it appeared in the input (e.g. "-0700", "-07:00", "MST")
+func (pt *ParseTime) ParseWithOffsetToken(value string) (time.Time, string, error) {
+	dt, err := pt.parse(value)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	t, err := pt.toTime(dt)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	return t, dt.zone(), nil
+}
+
+// ParsePreferLocations parses date/time string like Parse,
+// but resolves a timezone abbreviation to the first location in locs that uses it.
+// If the input also has a numeric offset, the location must match that offset too.
+func (pt *ParseTime) ParsePreferLocations(value string, locs []*time.Location) (time.Time, error) {
+	dt, err := pt.parse(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t, err := pt.toTime(dt)
+	if err != nil || dt.abbr == "" {
+		return t, err
+	}
+
+	_, hint := t.In(dt.loc).Zone()
+	for _, loc := range locs {
+		candidate := dt
+		candidate.loc = loc
+		ct, err := pt.toTime(candidate)
+		if err != nil {
+			continue
+		}
+
+		name, offset := ct.In(loc).Zone()
+		if name != dt.abbr || (dt.offset != "" && offset != hint) {
+			continue
+		}
+
+		return ct, nil
+	}
+
+	return t, nil
+}
+
+// ParseTryLocations parses date/time string like Parse, but if the input has no offset/timezone,
+// it tries each location in locs in order and returns the first time for which pred returns true (any time if pred is nil).
+// It is an error if no location satisfies pred.
+func (pt *ParseTime) ParseTryLocations(value string, locs []*time.Location, pred func(time.Time) bool) (time.Time, error) {
+	dt, err := pt.parse(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if dt.zone() != "" {
+		return pt.toTime(dt)
+	}
+
+	for _, loc := range locs {
+		candidate := dt
+		candidate.loc = loc
+		t, err := pt.toTime(candidate)
+		if err != nil {
+			continue
+		}
+
+		if pred == nil || pred(t) {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errNoLocation
 }
 
 func isRFC2822Abbrs(abbr string) bool {
-	return abbr == "EST" || abbr == "EDT" || abbr == "CST" || abbr == "CDT" || abbr == "MST" || abbr == "MDT" || abbr == "PST" || abbr == "PDT"
+	_, ok := rfc2822Offsets[abbr]
+	return ok
+}
+
+// abbrOffset returns the offset of the timezone abbreviation.
+// RFC2822 abbreviations are ambiguous in the timezone database, so their RFC2822 offsets are used.
+func abbrOffset(abbr string) (int, error) {
+	if offset, ok := rfc2822Offsets[abbr]; ok {
+		return offset, nil
+	}
+
+	tz := timezone.New()
+	tzAbbrInfo, err := tz.GetTzAbbreviationInfo(abbr)
+	if err != nil {
+		return 0, err
+	}
+
+	return tzAbbrInfo[0].Offset(), nil
+}
+
+// abbrOffsets returns the offsets that the timezone abbreviation can have:
+// its RFC2822 offset and its offsets in the timezone database, which may be ambiguous (e.g. "CST")
+func abbrOffsets(abbr string) []int {
+	switch strings.ToUpper(abbr) {
+	case "UTC", "GMT", "ZULU":
+		return []int{0}
+	}
+
+	var offsets []int
+	if offset, ok := rfc2822Offsets[abbr]; ok {
+		offsets = append(offsets, offset)
+	}
+
+	tz := timezone.New()
+	if tzAbbrInfo, err := tz.GetTzAbbreviationInfo(abbr); err == nil {
+		for _, info := range tzAbbrInfo {
+			offsets = append(offsets, info.Offset())
+		}
+	}
+
+	return offsets
+}
+
+// SupportedAbbreviations returns the sorted timezone abbreviations that the parser can resolve:
+// the RFC2822 abbreviations and the unambiguous abbreviations of the timezone database
+func SupportedAbbreviations() []string {
+	abbrs := make([]string, 0, len(rfc2822Offsets))
+	for abbr := range rfc2822Offsets {
+		abbrs = append(abbrs, abbr)
+	}
+
+	tz := timezone.New()
+	for abbr := range tz.TzAbbrInfos() {
+		if isRFC2822Abbrs(abbr) {
+			continue
+		}
+
+		if _, err := parseOffset(abbr); err == nil {
+			abbrs = append(abbrs, abbr)
+		}
+	}
+
+	sort.Strings(abbrs)
+
+	return abbrs
 }
@@ -1,6 +1,8 @@
 package parsetime
 
 import (
+	"errors"
+	"regexp"
 	"testing"
 	"time"
 
@@ -286,13 +288,105 @@ var iso8601Times = []TestTime{
 		Value: "2006-01-02T15:04:05.999999999Z",
 		Time:  createTime("2006-01-02T15:04:05.999999999Z0700", "2006-01-02T15:04:05.999999999Z"),
 	},
+	{
+		Value: "2024-01-15T14:30Z",
+		Time:  createTime("2006-01-02T15:04Z07:00", "2024-01-15T14:30Z"),
+	},
+	{
+		Value: "2024-01-15T14:30+09:00",
+		Time:  createTime("2006-01-02T15:04Z07:00", "2024-01-15T14:30+09:00"),
+	},
+	{
+		Value: "2024-01-15 14:30:00 JST",
+		Time:  createTime(time.RFC3339, "2024-01-15T14:30:00+09:00"),
+	},
+	{
+		Value: "2024-01-15T14:30:00 PST",
+		Time:  createTime(time.RFC3339, "2024-01-15T14:30:00-08:00"),
+	},
+	{
+		Value: "2006-01-02T15:04:05-0700",
+		Time:  createTime(time.RFC3339, "2006-01-02T15:04:05-07:00"),
+	},
+	{
+		Value: "2024-01-15Z",
+		Time:  createTime(time.RFC3339, "2024-01-15T00:00:00Z"),
+	},
+	{
+		Value: "2024-01-15+09:00",
+		Time:  createTime(time.RFC3339, "2024-01-15T00:00:00+09:00"),
+	},
+	{
+		Value: "2024-01-15-05:00",
+		Time:  createTime(time.RFC3339, "2024-01-15T00:00:00-05:00"),
+	},
+	{
+		Value: "2024-01-15T14Z",
+		Time:  createTime(time.RFC3339, "2024-01-15T14:00:00Z"),
+	},
+	{
+		Value: "2024-01-15T14+09:00",
+		Time:  createTime(time.RFC3339, "2024-01-15T14:00:00+09:00"),
+	},
+	{
+		Value: "2024.01.15",
+		Time:  createTimeInLocation("2006-01-02", "2024-01-15", time.Local),
+	},
+	{
+		Value: "2024.01.15 14:30:00",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2024-01-15T14:30:00", time.Local),
+	},
+	{
+		Value: "2024.01.15T14:30:00Z",
+		Time:  createTime(time.RFC3339, "2024-01-15T14:30:00Z"),
+	},
+	{
+		Value: "2024/01/15",
+		Time:  createTimeInLocation("2006-01-02", "2024-01-15", time.Local),
+	},
+	{
+		Value: "2024/1/5",
+		Time:  createTimeInLocation("2006-01-02", "2024-01-05", time.Local),
+	},
+	{
+		Value: "2024/1/15 14:30",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2024-01-15T14:30:00", time.Local),
+	},
+	{
+		Value: "2024/12/31 23:59:59 +09:00",
+		Time:  createTime(time.RFC3339, "2024-12-31T23:59:59+09:00"),
+	},
+	{
+		Value: "2024-Jan-15",
+		Time:  createTimeInLocation("2006-01-02", "2024-01-15", time.Local),
+	},
+	{
+		Value: "2024-January-15",
+		Time:  createTimeInLocation("2006-01-02", "2024-01-15", time.Local),
+	},
+	{
+		Value: "2024-February-29T10:00:00Z",
+		Time:  createTime(time.RFC3339, "2024-02-29T10:00:00Z"),
+	},
 }
 
 var rfc8xx1123Times = []TestTime{
+	{
+		Value: "Mon, 02 Jan 2006 15:04:05 Z",
+		Time:  createTime(time.RFC3339, "2006-01-02T15:04:05Z"),
+	},
+	{
+		Value: "Thu, 02 February 2006 15:04:05 Z",
+		Time:  createTime(time.RFC3339, "2006-02-02T15:04:05Z"),
+	},
 	{
 		Value: "02-Jan-06 1504 MST",
 		Time:  createTimeInLocation("02-Jan-06 15:04:05 MST", "02-Jan-06 15:04:00 MST", loc),
 	},
+	{
+		Value: "2 Jan '24",
+		Time:  createTimeInLocation("2006-01-02", "2024-01-02", time.Local),
+	},
 	{
 		Value: "02-Jan-06 15:04 MST",
 		Time:  createTimeInLocation("02-Jan-06 15:04:05 MST", "02-Jan-06 15:04:00 MST", loc),
@@ -449,6 +543,14 @@ var rfc8xx1123Times = []TestTime{
 		Value: "Mon, 02-Jan-00 15:04:05.999999999 -07:00",
 		Time:  createTimeInLocation("Mon, 02-Jan-2006 15:04:05.999999999 -07:00", "Mon, 02-Jan-2000 15:04:05.999999999 -07:00", loc),
 	},
+	{
+		Value: "02 Jan 2006 15:04:05 MST",
+		Time:  createTimeInLocation(time.RFC1123, "Mon, 02 Jan 2006 15:04:05 MST", loc),
+	},
+	{
+		Value: "Mon, 02 Jan 2006 15:04:05 MST",
+		Time:  createTimeInLocation(time.RFC1123, "Mon, 02 Jan 2006 15:04:05 MST", loc),
+	},
 }
 
 var ansicTimes = []TestTime{
@@ -540,6 +642,18 @@ var ansicTimes = []TestTime{
 		Value: "Jan 02 15:04:05.999999999",
 		Time:  createCurrentYearInLocation("Jan 02 15:04:05.999999999", "Jan 02 15:04:05.999999999", time.Local),
 	},
+	{
+		Value: "Mon Jan 15 14:30:05 UTC 2024",
+		Time:  createTime(time.RFC3339, "2024-01-15T14:30:05Z"),
+	},
+	{
+		Value: "Mon Jan 15 14:30:05 PST 2024",
+		Time:  createTime(time.RFC3339, "2024-01-15T14:30:05-08:00"),
+	},
+	{
+		Value: "Mon Jan  5 14:30:05 UTC 2024",
+		Time:  createTime(time.RFC3339, "2024-01-05T14:30:05Z"),
+	},
 }
 
 var usTimes = []TestTime{
@@ -731,6 +845,26 @@ var usTimes = []TestTime{
 		Value: "Jan 2, 2006",
 		Time:  createTimeInLocation("2006-01-02", "2006-01-02", time.Local),
 	},
+	{
+		Value: "Monday, January 2, 2006",
+		Time:  createTimeInLocation("2006-01-02", "2006-01-02", time.Local),
+	},
+	{
+		Value: "Mon Jan 2, 2006",
+		Time:  createTimeInLocation("2006-01-02", "2006-01-02", time.Local),
+	},
+	{
+		Value: "Jan 2 '06",
+		Time:  createTimeInLocation("2006-01-02", "2006-01-02", time.Local),
+	},
+	{
+		Value: "Jan 2, '06",
+		Time:  createTimeInLocation("2006-01-02", "2006-01-02", time.Local),
+	},
+	{
+		Value: "Monday, January 2, 2006 3:04 PM",
+		Time:  createTimeInLocation("2006-01-02T15:04:00", "2006-01-02T15:04:00", time.Local),
+	},
 	{
 		Value: "Jan 2, 2006 at 3:04am (MST)",
 		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T03:04:00", loc),
@@ -763,6 +897,22 @@ var usTimes = []TestTime{
 		Value: "Jan 2, 2006 at 03:04 pm (MST)",
 		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:00", loc),
 	},
+	{
+		Value: "Jan 2, 2006 at 12:04am (MST)",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T00:04:00", loc),
+	},
+	{
+		Value: "Jan 2, 2006 at 12:04pm (MST)",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T12:04:00", loc),
+	},
+	{
+		Value: "Jan 2, 2006 at 12:04:05 AM (MST)",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T00:04:05", loc),
+	},
+	{
+		Value: "Jan 2, 2006 at 12:04:05 PM (MST)",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T12:04:05", loc),
+	},
 	{
 		Value: "Jan 2, 2006 at 3:04:05am (MST)",
 		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T03:04:05", loc),
@@ -843,6 +993,26 @@ var usTimes = []TestTime{
 		Value: "Jan 2, 2006 at 3:04:05pm -07:00",
 		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:05", loc),
 	},
+	{
+		Value: "January 2, 2006 at 3:04 PM -07:00",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:00", loc),
+	},
+	{
+		Value: "January 2, 2006 AT 3:04 PM -07:00",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:00", loc),
+	},
+	{
+		Value: "January 2, 2006 3:04 PM -07:00",
+		Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:00", loc),
+	},
+	{
+		Value: "Jan 2, 2006 14:30 Z",
+		Time:  createTime(time.RFC3339, "2006-01-02T14:30:00Z"),
+	},
+	{
+		Value: "Jan 2, 2006 at 2:30pm Z",
+		Time:  createTime(time.RFC3339, "2006-01-02T14:30:00Z"),
+	},
 }
 
 type TestTime struct {
@@ -991,6 +1161,248 @@ func TestNewParseTimeFixedZone(test *testing.T) {
 
 func TestISO8601(test *testing.T) {
 	testTimes(iso8601Times, "ISO8601", test)
+
+	p, _ := NewParseTime()
+	_, err := p.ISO8601("2024-01-15T24Z")
+	assert.Equal(test, errInvalidDateTime, err, "Invalid date/time")
+
+	// the local time zone before 1900 may be LMT, so use UTC
+	p, _ = NewParseTime(time.UTC)
+	t, err := p.ISO8601("1582-Oct-15")
+	assert.Equal(test, nil, err, "Invalid date/time")
+	assert.Equal(test, time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC), t, "Parse error")
+
+	// the separators of the date are the same
+	for _, value := range []string{"2024.01-15", "2024/01-15", "2024-0115", "2024.01-15 10:00"} {
+		_, err = p.ISO8601(value)
+		assert.Equal(test, errInvalidDate, err, "Invalid date: "+value)
+
+		_, err = p.Parse(value)
+		assert.Equal(test, errInvalidDate, err, "Invalid date: "+value)
+	}
+}
+
+func TestISO8601MixedSeparators(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{
+			// extended date, basic time
+			Value: "2024-01-15t143005",
+			Time:  time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC),
+		},
+		{
+			// basic date, extended time
+			Value: "20240115T14:30:05",
+			Time:  time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15T143005Z",
+			Time:  time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC),
+		},
+		{
+			Value: "20240115t14:30:05+09:00",
+			Time:  time.Date(2024, 1, 15, 14, 30, 5, 0, time.FixedZone("", 9*3600)),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.ISO8601(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+
+		t, err = p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+}
+
+func TestISO8601WeekDate(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{
+			Value: "+002024-W03-1",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-W03-1",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024W037",
+			Time:  time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// Monday of the week
+			Value: "2024-W03",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024W03",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-W03T09:00:00Z",
+			Time:  time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-W03-1T14:30:00Z",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "2020-W01-1",
+			Time:  time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2020-W53-5",
+			Time:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "+002024-01-15T14:30:00+09:00",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600)),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.ISO8601(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+
+		t, err = p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	// 2021 and 2024 have 52 weeks
+	for _, value := range []string{"2021-W53-1", "2024-W53", "2024-W00"} {
+		_, err := p.ISO8601(value)
+		assert.Equal(errInvalidWeek, err, "Invalid week: "+value)
+
+		_, err = p.Parse(value)
+		assert.Equal(errInvalidWeek, err, "Invalid week: "+value)
+	}
+}
+
+func TestISO8601MonthPrecision(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{
+			Value: "2024-01",
+			Time:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-12",
+			Time:  time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "1582-10",
+			Time:  time.Date(1582, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.ISO8601(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+
+		t, err = p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+}
+
+func TestSetISOYearPrecision(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)))
+
+	t, err := p.Parse("2024")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 10, 20, 24, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetISOYearPrecision(true)
+
+	times := []TestTime{
+		{
+			Value: "2024",
+			Time:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "1582",
+			Time:  time.Date(1582, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "20240115",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "1705329000",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15T14:30:00Z",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	t, err = p.ISO8601("2024")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestISO8601WithoutYear(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)))
+
+	times := []TestTime{
+		{
+			Value: "--01-15",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "--12-31",
+			Time:  time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "--0229",
+			Time:  time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "--01-15T10:30:00+09:00",
+			Time:  time.Date(2024, 1, 15, 10, 30, 0, 0, time.FixedZone("", 9*3600)),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.ISO8601(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+
+		t, err = p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	t, err := p.ParseWithYear("--12-31", 2023)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
 }
 
 func TestRFC8xx1123(test *testing.T) {
@@ -999,6 +1411,39 @@ func TestRFC8xx1123(test *testing.T) {
 
 func TestANSIC(test *testing.T) {
 	testTimes(ansicTimes, "ANSIC", test)
+
+	// date -u
+	p, _ := NewParseTime()
+	t, err := p.ANSIC("Mon Jan 15 14:30:05 UTC 2024")
+	assert.Equal(test, nil, err, "Invalid date/time")
+	assert.Equal(test, time.UTC, t.Location(), "Incorrect location")
+
+	// ctime without seconds
+	p, _ = NewParseTime(time.UTC)
+	times := []TestTime{
+		{
+			Value: "Mon Jan  2 15:04 2006",
+			Time:  time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC),
+		},
+		{
+			Value: "Jan 2 15:04 2006",
+			Time:  time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC),
+		},
+		{
+			Value: "Mon Jan  2 15:04 -0700 2006",
+			Time:  time.Date(2006, 1, 2, 15, 4, 0, 0, time.FixedZone("", -7*3600)),
+		},
+	}
+
+	for _, tt := range times {
+		t, err = p.ANSIC(tt.Value)
+		assert.Equal(test, nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(test, tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+
+		t, err = p.Parse(tt.Value)
+		assert.Equal(test, nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(test, tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
 }
 
 func TestUS(test *testing.T) {
@@ -1020,3 +1465,1101 @@ func TestParse(test *testing.T) {
 	testTimes(ansicTimes, "Parse", test)
 	testTimes(usTimes, "Parse", test)
 }
+
+func TestParsePreferLocations(test *testing.T) {
+	assert := assert.New(test)
+
+	chicago := createLocation("America/Chicago")
+	shanghai := createLocation("Asia/Shanghai")
+
+	p, _ := NewParseTime()
+
+	t, err := p.ParsePreferLocations("Mon, 02 Jan 2006 15:04:05 CST", []*time.Location{chicago, shanghai})
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(-6*3600, getOffset(t), "Incorrect offset")
+	assert.Equal(chicago.String(), t.Location().String(), "Incorrect location")
+
+	t, err = p.ParsePreferLocations("Mon, 02 Jan 2006 15:04:05 CST", []*time.Location{shanghai, chicago})
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(8*3600, getOffset(t), "Incorrect offset")
+	assert.Equal(shanghai.String(), t.Location().String(), "Incorrect location")
+
+	t, err = p.ParsePreferLocations("2006-01-02 15:04:05 +08:00 CST", []*time.Location{chicago, shanghai})
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(8*3600, getOffset(t), "Incorrect offset")
+	assert.Equal(shanghai.String(), t.Location().String(), "Incorrect location")
+	assert.Equal(createTime("2006-01-02T15:04:05-07:00", "2006-01-02T15:04:05+08:00").Unix(), t.Unix(), "Parse error")
+}
+
+func TestParseTryLocations(test *testing.T) {
+	assert := assert.New(test)
+
+	tokyo := createLocation("Asia/Tokyo")
+	newYork := createLocation("America/New_York")
+
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	notFuture := func(t time.Time) bool {
+		return !t.After(now)
+	}
+
+	p, _ := NewParseTime(time.UTC)
+
+	// 2024-01-15 08:00 in Tokyo is 2024-01-14 23:00 UTC, in New York 2024-01-15 13:00 UTC
+	t, err := p.ParseTryLocations("2024-01-15 08:00:00", []*time.Location{newYork, tokyo}, notFuture)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 14, 23, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+	assert.Equal(tokyo.String(), t.Location().String(), "Incorrect location")
+
+	t, err = p.ParseTryLocations("2024-01-15 08:00:00", []*time.Location{newYork, tokyo}, nil)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+	assert.Equal(newYork.String(), t.Location().String(), "Incorrect location")
+
+	t, err = p.ParseTryLocations("2024-01-15 08:00:00 -05:00", []*time.Location{tokyo}, notFuture)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	_, err = p.ParseTryLocations("2024-01-15 20:00:00", []*time.Location{newYork, tokyo}, notFuture)
+	assert.Equal(errNoLocation, err, "No matching location")
+
+	_, err = p.ParseTryLocations("garbage", []*time.Location{newYork, tokyo}, notFuture)
+	assert.NotEqual(nil, err, "Parse error")
+}
+
+func TestParseWithOffsetToken(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	tokens := map[string]string{
+		"02 Jan 2006 15:04:05 -0700":     "-0700",
+		"2006-01-02T15:04:05-07:00":      "-07:00",
+		"02 Jan 2006 15:04:05 MST":       "MST",
+		"2006-01-02T15:04:05Z":           "Z",
+		"2006-01-02 15:04:05 -07:00 MST": "-07:00 MST",
+		"2006-01-02 15:04:05":            "",
+	}
+
+	for value, token := range tokens {
+		t, tok, err := p.ParseWithOffsetToken(value)
+		t2, _ := p.Parse(value)
+
+		assert.Equal(nil, err, "Invalid date/time")
+		assert.Equal(token, tok, "Incorrect offset token")
+		assert.Equal(t2.Unix(), t.Unix(), "Parse error")
+	}
+}
+
+func TestRegisterAMPM(test *testing.T) {
+	am, pm := amMarkers, pmMarkers
+	test.Cleanup(func() {
+		amMarkers, pmMarkers = am, pm
+		US = usPattern()
+		reUS = regexp.MustCompile(US)
+		reHourAMPM = regexp.MustCompile(hourAMPMPattern())
+		reRelativeTime = regexp.MustCompile(relativeTimePattern())
+	})
+
+	RegisterAMPM("午前", "午後")
+
+	times := []TestTime{
+		{
+			Value: "01/02/2006 3:04:05 午後",
+			Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:05", time.Local),
+		},
+		{
+			Value: "01/02/2006 3:04:05午前",
+			Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T03:04:05", time.Local),
+		},
+		{
+			Value: "01/02/2006 3:04:05 PM",
+			Time:  createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:05", time.Local),
+		},
+	}
+
+	testTimes(times, "US", test)
+	testTimes(times, "Parse", test)
+}
+
+func TestRegisterMonthAbbreviations(test *testing.T) {
+	assert := assert.New(test)
+
+	months := monthAbbreviations
+	test.Cleanup(func() {
+		monthAbbreviations = months
+		ISO8601 = iso8601Pattern()
+		RFC8xx1123 = rfc8xx1123Pattern()
+		ANSIC = ansicPattern()
+		US = usPattern()
+		reISO8601 = regexp.MustCompile(ISO8601)
+		reRFC8xx1123 = regexp.MustCompile(RFC8xx1123)
+		reANSIC = regexp.MustCompile(ANSIC)
+		reUS = regexp.MustCompile(US)
+	})
+	monthAbbreviations = map[string]int{}
+	for name, month := range months {
+		monthAbbreviations[name] = month
+	}
+
+	assert.Equal(nil, RegisterMonthAbbreviations(map[string]int{"Sept": 9, "juil.": 7}), "Register error")
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{
+			Value: "Sept 15 14:30:00 2024",
+			Time:  time.Date(2024, 9, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "Sun, 15 Sept 2024 14:30:00 +0000",
+			Time:  time.Date(2024, 9, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "15 juil. 2024 14:30:00 +0200",
+			Time:  time.Date(2024, 7, 15, 14, 30, 0, 0, time.FixedZone("", 2*3600)),
+		},
+		{
+			Value: "Sep 15 14:30:00 2024",
+			Time:  time.Date(2024, 9, 15, 14, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	assert.Equal(errInvalidArgs, RegisterMonthAbbreviations(map[string]int{"Foo": 13}), "Register error")
+}
+
+func TestParseHint(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	t, err := p.ParseHint("2006-01-02T15:04:05-07:00", "ISO8601")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(createTime(time.RFC3339, "2006-01-02T15:04:05-07:00").Unix(), t.Unix(), "Parse error")
+
+	_, err = p.ParseHint("Mon, 02 Jan 2006 15:04:05 MST", "ISO8601")
+	assert.Equal(errInvalidDateTime, err, "Parse error")
+
+	t, err = p.ParseHint("Mon, 02 Jan 2006 15:04:05 MST", "RFC8xx1123")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(createTimeInLocation(time.RFC1123, "Mon, 02 Jan 2006 15:04:05 MST", loc).Unix(), t.Unix(), "Parse error")
+
+	_, err = p.ParseHint("2006-01-02T15:04:05-07:00", "Unknown")
+	assert.Equal(errUnknownFormat, err, "Unknown format")
+}
+
+func TestIsSupportedFormat(test *testing.T) {
+	assert := assert.New(test)
+
+	for _, name := range []string{"ISO8601", "RFC8xx1123", "ANSIC", "US", "Week", "TimeString", "Epoch"} {
+		assert.True(IsSupportedFormat(name), "Unknown format: "+name)
+	}
+
+	for _, name := range []string{"", "Unknown", "iso8601", "RFC3339", "ISO8601 "} {
+		assert.False(IsSupportedFormat(name), "Supported format: "+name)
+	}
+}
+
+func TestParseDetailed(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime()
+
+	r, err := p.ParseDetailed("2006-01-02T15:04:05-07:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(createTime(time.RFC3339, "2006-01-02T15:04:05-07:00").Unix(), r.Time.Unix(), "Parse error")
+	assert.Equal("ISO8601", r.Format, "Incorrect format")
+	assert.Equal(0, r.Priority, "Incorrect priority")
+	assert.Equal(true, r.ExplicitZone, "Incorrect explicit zone")
+	assert.Equal("", r.Leftover, "Incorrect leftover")
+
+	r, err = p.ParseDetailed("Mon, 02 Jan 2006 15:04:05 MST")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("RFC8xx1123", r.Format, "Incorrect format")
+	assert.Equal(true, r.ExplicitZone, "Incorrect explicit zone")
+
+	r, err = p.ParseDetailed("2006-01-02 15:04:05 !!")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(createTimeInLocation("2006-01-02T15:04:05", "2006-01-02T15:04:05", time.Local).Unix(), r.Time.Unix(), "Parse error")
+	assert.Equal("ISO8601", r.Format, "Incorrect format")
+	assert.Equal(2, r.Priority, "Incorrect priority")
+	assert.Equal(false, r.ExplicitZone, "Incorrect explicit zone")
+	assert.Equal("!!", r.Leftover, "Incorrect leftover")
+
+	_, err = p.ParseDetailed("")
+	assert.Equal(errInvalidDateTime, err, "Parse error")
+}
+
+func TestSetWordyOffsets(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetWordyOffsets(true)
+
+	times := []TestTime{
+		{
+			Value: "2024-01-15 14:30:00 UTC minus 5",
+			Time:  createTime(time.RFC3339, "2024-01-15T14:30:00-05:00"),
+		},
+		{
+			Value: "2024-01-15 14:30:00 UTC plus 5:30",
+			Time:  createTime(time.RFC3339, "2024-01-15T14:30:00+05:30"),
+		},
+		{
+			Value: "2024-01-15 14:30:00 5 hours behind UTC",
+			Time:  createTime(time.RFC3339, "2024-01-15T14:30:00-05:00"),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time")
+		assert.Equal(getOffset(tt.Time), getOffset(t), "Incorrect offset")
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error")
+	}
+
+	offset, err := parseWordyOffset("UTC plus 5:30")
+	assert.Equal(nil, err, "Invalid offset")
+	assert.Equal("+05:30", offset, "Incorrect offset")
+
+	p.SetWordyOffsets(false)
+
+	t, _ := p.Parse("2024-01-15 14:30:00 UTC minus 5")
+	assert.NotEqual(-5*3600, getOffset(t), "Incorrect offset")
+}
+
+func TestParseDate(test *testing.T) {
+	assert := assert.New(test)
+
+	jst := time.FixedZone("JST", 9*3600)
+	p, _ := NewParseTime(jst)
+
+	date := time.Date(2006, 1, 2, 0, 0, 0, 0, jst)
+
+	for _, value := range []string{
+		"2006-01-02",
+		"2006-01-02 15:04:05",
+		"2006-01-02T23:59:59.999999999",
+		"Jan 2, 2006 at 3:04pm",
+		"2006-01-01T20:00:00Z",
+	} {
+		t, err := p.ParseDate(value)
+		assert.Equal(nil, err, "Invalid date/time")
+		assert.Equal(date, t, "Parse error")
+	}
+}
+
+func TestUnknownZoneLeftUnparsed(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.Parse("2024-01-15 14:30:00 FOO")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	r, err := p.ParseDetailed("2024-01-15 14:30:00 FOO")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("ISO8601", r.Format, "Format error")
+	assert.Equal("FOO", r.Leftover, "Leftover error")
+	assert.False(r.ExplicitZone, "Zone error")
+}
+
+func TestParseLocation(test *testing.T) {
+	assert := assert.New(test)
+
+	for value, want := range map[string]int{
+		"Z":      0,
+		"z":      0,
+		"Zulu":   0,
+		"gmt":    0,
+		"-07:00": -7 * 3600,
+		"-0700":  -7 * 3600,
+		"+05:30": 5*3600 + 30*60,
+		"PST":    -8 * 3600,
+	} {
+		loc, err := ParseLocation(value)
+		assert.Equal(nil, err, "Invalid offset: "+value)
+		_, offset := time.Date(2024, 1, 15, 0, 0, 0, 0, loc).Zone()
+		assert.Equal(want, offset, "Parse error: "+value)
+	}
+
+	loc, err := ParseLocation("PST")
+	assert.Equal(nil, err, "Invalid offset")
+	assert.Equal("PST", loc.String(), "Parse error")
+
+	_, err = ParseLocation("-07:00:00:00")
+	assert.Equal(errInvalidOffset, err, "Invalid offset")
+
+	for _, value := range []string{"+15:00", "-1500", "+09:60"} {
+		_, err = ParseLocation(value)
+		assert.Equal(errOffsetRange, err, "Offset out of range: "+value)
+	}
+
+	p, _ := NewParseTime(time.UTC)
+	for _, value := range []string{"2024-01-15T14:30:00+15:00", "2024-01-15 14:30:00 -15:00", "2024-01-15T14:30:00+1500"} {
+		_, err = p.Parse(value)
+		assert.Equal(errOffsetRange, err, "Offset out of range: "+value)
+	}
+
+	_, err = p.Parse("2024-01-15T14:30:00+14:00")
+	assert.Equal(nil, err, "Invalid offset")
+}
+
+func TestParseUTCWords(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime("Asia/Tokyo")
+
+	for _, value := range []string{
+		"2024-01-15T14:30:00 UTC",
+		"2024-01-15T14:30:00 utc",
+		"2024-01-15T14:30:00 GMT",
+		"2024-01-15T14:30:00 Zulu",
+		"2024-01-15 14:30:00 zulu",
+		"Mon, 15 Jan 2024 14:30:00 UTC",
+		"Mon, 15 Jan 2024 14:30:00 gmt",
+		"Mon, 15 Jan 2024 14:30:00 Zulu",
+	} {
+		r, err := p.ParseDetailed(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC), r.Time, "Parse error: "+value)
+		assert.Equal("", r.Leftover, "Leftover error: "+value)
+	}
+}
+
+func TestSupportedAbbreviations(test *testing.T) {
+	assert := assert.New(test)
+
+	abbrs := SupportedAbbreviations()
+
+	for _, abbr := range []string{"EST", "EDT", "CST", "CDT", "MST", "MDT", "PST", "PDT", "JST", "UTC"} {
+		assert.Contains(abbrs, abbr, "Missing abbreviation")
+	}
+	assert.NotContains(abbrs, "IST", "Ambiguous abbreviation")
+
+	for _, abbr := range abbrs {
+		_, err := parseOffset(abbr)
+		assert.Equal(nil, err, "Invalid abbreviation: "+abbr)
+	}
+}
+
+func TestParseToUnix(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	sec, err := p.ParseToUnix("2024-01-15T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(int64(1705329000), sec, "Parse error")
+
+	msec, err := p.ParseToUnixMilli("2024-01-15T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(int64(1705329000000), msec, "Parse error")
+
+	sec, err = p.ParseToUnix("2024-01-15T23:30:00+09:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(int64(1705329000), sec, "Parse error")
+
+	_, err = p.ParseToUnix("invalid")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+}
+
+func TestParseForColumn(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	value := "2024-01-15T14:30:05.123456789Z"
+	columns := map[int]time.Time{
+		0: time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC),
+		3: time.Date(2024, 1, 15, 14, 30, 5, 123000000, time.UTC),
+		6: time.Date(2024, 1, 15, 14, 30, 5, 123456000, time.UTC),
+		9: time.Date(2024, 1, 15, 14, 30, 5, 123456789, time.UTC),
+	}
+
+	for digits, want := range columns {
+		t, err := p.ParseForColumn(value, digits)
+		assert.Equal(nil, err, "Invalid date/time")
+		assert.Equal(want.UnixNano(), t.UnixNano(), "Parse error")
+	}
+
+	// truncated, not rounded
+	t, err := p.ParseForColumn("2024-01-15T14:30:05.9999Z", 3)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 5, 999000000, time.UTC).UnixNano(), t.UnixNano(), "Parse error")
+
+	for _, digits := range []int{-1, 10} {
+		_, err = p.ParseForColumn(value, digits)
+		assert.Equal(errInvalidArgs, err, "Invalid arguments")
+	}
+
+	_, err = p.ParseForColumn("invalid", 3)
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+}
+
+func TestSetEnabledFormats(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.Parse("01/02/2006 3:04:05 PM")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetEnabledFormats("ISO8601", "Unknown")
+
+	_, err = p.Parse("01/02/2006 3:04:05 PM")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+
+	t, err = p.Parse("2006-01-02T15:04:05Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetEnabledFormats()
+
+	t, err = p.Parse("01/02/2006 3:04:05 PM")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestSetFormatWeight(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	// RFC8xx1123 matches the whole string, but ISO8601 is more specific
+	r, err := p.ParseDetailed("20240115 1")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("ISO8601", r.Format, "Parse error")
+	assert.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC).Unix(), r.Time.Unix(), "Parse error")
+	assert.Equal(1, r.Priority, "Parse error")
+
+	r, err = p.ParseDetailed("01/02/2006 15:04:05")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("RFC8xx1123", r.Format, "Parse error")
+
+	p.SetFormatWeight("US", 0)
+
+	r, err = p.ParseDetailed("01/02/2006 15:04:05")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("US", r.Format, "Parse error")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).Unix(), r.Time.Unix(), "Parse error")
+}
+
+func TestParseComponents(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	year, month, day, hour, min, sec, nsec, loc, err := p.ParseComponents("2024-01-15T14:30:05.25+09:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal([]int{2024, 1, 15, 14, 30, 5, 250000000}, []int{year, month, day, hour, min, sec, nsec}, "Parse error")
+	_, offset := time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc).Zone()
+	assert.Equal(9*3600, offset, "Parse error")
+
+	// not normalized to February 1
+	year, month, day, hour, min, sec, nsec, loc, err = p.ParseComponents("2024-01-32 10:00:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal([]int{2024, 1, 32, 10, 0, 0, 0}, []int{year, month, day, hour, min, sec, nsec}, "Parse error")
+	assert.Equal(time.UTC, loc, "Parse error")
+
+	year, month, day, _, _, _, _, _, err = p.ParseComponents("2024-01-32")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal([]int{2024, 1, 32}, []int{year, month, day}, "Parse error")
+
+	// not normalized to March 1
+	year, month, day, _, _, _, _, _, err = p.ParseComponents("2024-02-30 10:00:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal([]int{2024, 2, 30}, []int{year, month, day}, "Parse error")
+
+	year, month, day, _, _, _, _, _, err = p.ParseComponents("2024-13-01")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal([]int{2024, 13, 1}, []int{year, month, day}, "Parse error")
+
+	// Parse and the parsers reject them
+	for _, value := range []string{"2024-01-32", "2024-01-99", "2024-02-30 10:00:00", "2023-02-29", "2024-13-01", "2024-04-31T10:00:00Z"} {
+		_, err = p.Parse(value)
+		assert.Equal(errInvalidDate, err, "Invalid date: "+value)
+
+		_, err = p.ISO8601(value)
+		assert.Equal(errInvalidDate, err, "Invalid date: "+value)
+	}
+
+	t, err := p.Parse("2024-02-29")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), t, "Parse error")
+
+	_, _, _, _, _, _, _, _, err = p.ParseComponents("invalid")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+}
+
+func TestParseWithFixedZone(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.ParseWithFixedZone("2024-01-15 14:30:00", "JST", 9*3600)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("JST", 9*3600)).Unix(), t.Unix(), "Parse error")
+	name, offset := t.Zone()
+	assert.Equal("JST", name, "Parse error")
+	assert.Equal(9*3600, offset, "Parse error")
+
+	t, err = p.ParseWithFixedZone("2024-01-15T14:30:00-07:00", "JST", 9*3600)
+	assert.Equal(nil, err, "Invalid date/time")
+	_, offset = t.Zone()
+	assert.Equal(-7*3600, offset, "Parse error")
+
+	t, err = p.Parse("2024-01-15 14:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.UTC, t.Location(), "Parse error")
+}
+
+func TestParseLocal(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime("Asia/Tokyo")
+
+	t, err := p.ParseLocal("2024-01-15T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Local, t.Location(), "Parse error")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	_, err = p.ParseLocal("invalid")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+}
+
+func TestParseMissingTime(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 1, 5, 10, 11, 12, 500000000, time.UTC)))
+
+	times := []TestTime{
+		{
+			Value: "2006-01-02",
+			Time:  time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2 Jan 2006",
+			Time:  time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Jan 2, 2006",
+			Time:  time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Jan 2",
+			Time:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.UnixNano(), t.UnixNano(), "Parse error: "+tt.Value)
+		assert.Equal(0, t.Hour(), "Hour error: "+tt.Value)
+		assert.Equal(0, t.Minute(), "Minute error: "+tt.Value)
+		assert.Equal(0, t.Second(), "Second error: "+tt.Value)
+		assert.Equal(0, t.Nanosecond(), "Nanosecond error: "+tt.Value)
+	}
+}
+
+func TestNormalize(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	for _, value := range []string{
+		"2024-01-15T14:30:00Z",
+		"Mon, 15 Jan 2024 23:30:00 +09:00",
+		"01/15/2024 9:30:00 AM EST",
+	} {
+		s, err := p.Normalize(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal("2024-01-15T14:30:00Z", s, "Normalize error: "+value)
+	}
+
+	s, err := p.Normalize("2024-01-15T23:30:00.123456789+09:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("2024-01-15T14:30:00.123456789Z", s, "Normalize error")
+
+	_, err = p.Normalize("invalid")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+}
+
+func TestSetUnknownZonePolicy(test *testing.T) {
+	assert := assert.New(test)
+
+	jst := time.FixedZone("JST", 9*3600)
+	p, _ := NewParseTime(jst)
+	p.SetUnknownZonePolicy(UnknownZoneError)
+
+	for _, value := range []string{"2006-01-02 15:04:05 FOO", "2024-01-15 14:30:00 FOO"} {
+		_, err := p.ISO8601(value)
+		assert.Equal(errUnknownZone, err, "Invalid timezone: "+value)
+
+		_, err = p.Parse(value)
+		assert.Equal(errUnknownZone, err, "Invalid timezone: "+value)
+	}
+
+	p.SetUnknownZonePolicy(UnknownZoneUTC)
+
+	t, err := p.ISO8601("2006-01-02 15:04:05 FOO")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("Jan 2, 2006 at 3:04pm FOO")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetUnknownZonePolicy(UnknownZoneLocal)
+
+	t, err = p.ISO8601("2006-01-02 15:04:05 FOO")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, jst).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("Jan 2, 2006 at 3:04pm FOO")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 0, 0, jst).Unix(), t.Unix(), "Parse error")
+
+	// known abbreviations and numeric offsets are not affected
+	t, err = p.ISO8601("2006-01-02 15:04:05 MST")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestSetDecimalOffsets(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetDecimalOffsets(true)
+
+	times := []TestTime{
+		{
+			Value: "2024-01-15 14:30:00 +5.5",
+			Time:  createTime(time.RFC3339, "2024-01-15T14:30:00+05:30"),
+		},
+		{
+			Value: "2024-01-15T14:30:00-3.75",
+			Time:  createTime(time.RFC3339, "2024-01-15T14:30:00-03:45"),
+		},
+		{
+			Value: "Jan 2, 2006 at 3:04pm +5.5",
+			Time:  createTime(time.RFC3339, "2006-01-02T15:04:00+05:30"),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(getOffset(tt.Time), getOffset(t), "Incorrect offset: "+tt.Value)
+		assert.Equal(tt.Time.UnixNano(), t.UnixNano(), "Parse error: "+tt.Value)
+	}
+
+	offset, err := parseDecimalOffset("-3.75")
+	assert.Equal(nil, err, "Invalid offset")
+	assert.Equal("-03:45", offset, "Incorrect offset")
+
+	p.SetDecimalOffsets(false)
+
+	t, _ := p.Parse("2024-01-15 14:30:00 +5.5")
+	assert.NotEqual(5*3600+30*60, getOffset(t), "Incorrect offset")
+}
+
+func TestSetNormalizeFullWidth(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	_, err := p.Parse("２０２４－０１－１５Ｔ１４：３０：００Ｚ")
+	assert.NotEqual(nil, err, "Parse error")
+
+	p.SetNormalizeFullWidth(true)
+
+	times := []TestTime{
+		{
+			Value: "２０２４－０１－１５",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "２０２４－０１－１５Ｔ１４：３０：００Ｚ",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "２０２４／０１／１５　１４：３０：００　＋０９：００",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600)),
+		},
+		{
+			Value: "Ｍｏｎ， １５ Ｊａｎ ２０２４ １４：３０：００ ＧＭＴ",
+			Time:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	assert.Equal("2024-01-15 (Mon) ~", toHalfWidth("２０２４－０１－１５　（Ｍｏｎ）　～"), "Incorrect conversion")
+}
+
+func TestSetNormalizeWhitespace(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	value := "Jan\t2,\t2006  at   3:04pm"
+
+	result, err := p.ParseDetailed(value)
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.NotEqual("", result.Leftover, "Leftover error")
+
+	p.SetNormalizeWhitespace(true)
+
+	times := []TestTime{
+		{
+			Value: value,
+			Time:  time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC),
+		},
+		{
+			Value: "Mon  Jan   2 15:04:05\t2006",
+			Time:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			Value: "Jan  2 15:04:05 2006",
+			Time:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			Value: "02  Jan  2006   15:04:05 \t MST",
+			Time:  time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC),
+		},
+		{
+			Value: "\n2006-01-02\t\t15:04:05   Z\n",
+			Time:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		result, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), result.Time.Unix(), "Parse error: "+tt.Value)
+		assert.Equal("", result.Leftover, "Leftover error: "+tt.Value)
+	}
+}
+
+func TestCommaFractionalSecondsWithOffset(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{Value: "2024-01-15T14:30:05,250+09:00", Time: time.Date(2024, 1, 15, 14, 30, 5, 250000000, time.FixedZone("", 9*3600))},
+		{Value: "2024-01-15T14:30:05.250+09:00", Time: time.Date(2024, 1, 15, 14, 30, 5, 250000000, time.FixedZone("", 9*3600))},
+		{Value: "2024-01-15T14:30:05,5Z", Time: time.Date(2024, 1, 15, 14, 30, 5, 500000000, time.UTC)},
+		{Value: "2024-01-15 14:30:05,123456789 -07:00", Time: time.Date(2024, 1, 15, 14, 30, 5, 123456789, time.FixedZone("", -7*3600))},
+	}
+
+	for _, tt := range times {
+		r, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.UnixNano(), r.Time.UnixNano(), "Parse error: "+tt.Value)
+		assert.Equal(true, r.ExplicitZone, "Parse error: "+tt.Value)
+		assert.Equal("", r.Leftover, "Leftover error: "+tt.Value)
+	}
+}
+
+func TestZeroFractionalSeconds(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	want := time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC)
+
+	// a fraction without digits is zero, like an all-zero fraction
+	for _, value := range []string{
+		"2024-01-15T14:30:05.0Z",
+		"2024-01-15T14:30:05.000Z",
+		"2024-01-15T14:30:05.000000000Z",
+		"2024-01-15T14:30:05.Z",
+		"2024-01-15 14:30:05.",
+	} {
+		t, err := p.ISO8601(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(want.UnixNano(), t.UnixNano(), "Parse error: "+value)
+		assert.Equal(0, t.Nanosecond(), "Nanosecond error: "+value)
+
+		result, err := p.ParseDetailed(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(want.UnixNano(), result.Time.UnixNano(), "Parse error: "+value)
+		assert.Equal("", result.Leftover, "Leftover error: "+value)
+	}
+}
+
+func TestRFC8xx1123Comment(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{
+			Value: "Mon, 02 Jan 2006 15:04:05 -0700 (MST)",
+			Time:  createTime(time.RFC3339, "2006-01-02T15:04:05-07:00"),
+		},
+		{
+			Value: "Mon, 02 Jan 2006 15:04:05 -0700",
+			Time:  createTime(time.RFC3339, "2006-01-02T15:04:05-07:00"),
+		},
+		{
+			Value: "Mon, 02 Jan 2006 15:04:05 (MST)",
+			Time:  createTime(time.RFC3339, "2006-01-02T15:04:05-07:00"),
+		},
+		{
+			// the comment is ignored when there is a numeric offset
+			Value: "Mon, 02 Jan 2006 15:04:05 +0900 (MST)",
+			Time:  createTime(time.RFC3339, "2006-01-02T15:04:05+09:00"),
+		},
+		{
+			Value: "Mon, 02 Jan 2006 15:04:05 +0000 (Coordinated Universal Time)",
+			Time:  createTime(time.RFC3339, "2006-01-02T15:04:05Z"),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.RFC8xx1123(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+		assert.Equal(getOffset(tt.Time), getOffset(t), "Offset error: "+tt.Value)
+
+		result, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), result.Time.Unix(), "Parse error: "+tt.Value)
+		assert.Equal("", result.Leftover, "Leftover error: "+tt.Value)
+	}
+}
+
+func TestSetKeepInputZone(test *testing.T) {
+	assert := assert.New(test)
+
+	jst := time.FixedZone("JST", 9*3600)
+	p, _ := NewParseTime(jst)
+
+	t, err := p.Parse("2024-01-15T14:30:00-05:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(-5*3600, getOffset(t), "Offset error")
+
+	p.SetKeepInputZone(false)
+
+	converted, err := p.Parse("2024-01-15T14:30:00-05:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(9*3600, getOffset(converted), "Offset error")
+	assert.Equal(t.Unix(), converted.Unix(), "Parse error")
+	assert.Equal("2024-01-16 04:30:00 +0900 JST", converted.String(), "Parse error")
+
+	chicago, _ := time.LoadLocation("America/Chicago")
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+
+	t, err = p.ParsePreferLocations("2006-01-02 15:04:05 CST", []*time.Location{shanghai, chicago})
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, shanghai).Unix(), t.Unix(), "Parse error")
+	assert.Equal(9*3600, getOffset(t), "Offset error")
+
+	p.SetKeepInputZone(true)
+
+	t, err = p.Parse("2024-01-15T14:30:00-05:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(-5*3600, getOffset(t), "Offset error")
+}
+
+func TestChainedSetters(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	jst := time.FixedZone("JST", 9*3600)
+
+	assert.Equal(&p, p.WithLocation(jst).SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, jst))).SetEnabledFormats("US").SetStrict(true), "Incorrect receiver")
+
+	t, err := p.Parse("01/02/2006 15:04:05")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, jst).Unix(), t.Unix(), "Parse error")
+
+	_, err = p.Parse("2006-01-02T15:04:05Z")
+	assert.NotEqual(nil, err, "Parse error")
+
+	p.SetEnabledFormats().SetRequireTimezone(true).SetLeapSecondPolicy(LeapSecondReject)
+
+	_, err = p.Parse("2006-01-02 15:04:05")
+	assert.Equal(errMissingTimezone, err, "Missing timezone")
+
+	_, err = p.Parse("2016-12-31T23:59:60Z")
+	assert.Equal(errLeapSecond, err, "Leap second")
+
+	// SetLocation keeps its signature without the receiver
+	p.SetLocation(time.UTC)
+	assert.Equal(time.UTC, p.GetLocation(), "Incorrect location")
+}
+
+func TestSetBlankIsZero(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	_, err := p.Parse("")
+	assert.NotEqual(nil, err, "Parse error")
+
+	p.SetBlankIsZero(true)
+
+	for _, value := range []string{"", " ", "\t\n"} {
+		t, err := p.Parse(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.True(t.IsZero(), "Parse error: "+value)
+	}
+
+	_, err = p.Parse("garbage")
+	assert.NotEqual(nil, err, "Parse error")
+
+	t, err := p.Parse("2024-01-15T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestFindSubmatch(test *testing.T) {
+	assert := assert.New(test)
+
+	re := regexp.MustCompile(`^([0-9]+)(?:[.]([0-9]+))?$`)
+
+	group := findSubmatch(re, "1705329000", 2)
+	assert.Equal([]string{"1705329000", "1705329000", ""}, group, "Parse error")
+
+	assert.Nil(findSubmatch(re, "garbage", 2), "Parse error")
+	assert.Nil(findSubmatch(re, "1705329000", 3), "Parse error")
+}
+
+func TestMinimalMatches(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	parsers := map[string]func(string) (time.Time, error){
+		"Parse":      p.Parse,
+		"ISO8601":    p.ISO8601,
+		"RFC8xx1123": p.RFC8xx1123,
+		"ANSIC":      p.ANSIC,
+		"US":         p.US,
+		"Week":       p.Week,
+		"TimeString": p.TimeString,
+		"TimeOnly":   p.TimeOnly,
+		"Epoch":      p.Epoch,
+	}
+
+	values := []string{"", " ", "1", "12", "Z", "T", "W", "@", ":", "-", "/", "PM", "Mon", "Jan", "1/2", "2 Jan", "Jan 2", "T15", "2024", "2024-W", "+09:00"}
+
+	for name, parse := range parsers {
+		for _, value := range values {
+			assert.NotPanics(func() { parse(value) }, name+": "+value)
+		}
+	}
+}
+
+func TestParseOrdered(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	// RFC8xx1123 (day first) wins by its weight
+	t, err := p.Parse("01/02/06")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetEnabledFormats("US", "RFC8xx1123")
+
+	t, err = p.ParseOrdered("01/02/06")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("01/02/06")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetEnabledFormats("RFC8xx1123", "US")
+
+	t, err = p.ParseOrdered("01/02/06")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	// the first match wins even if a later format parses more of the string
+	p.SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)))
+	p.SetEnabledFormats("ANSIC", "US")
+
+	r, err := p.ParseDetailed("01/02/2006 15:04")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal("US", r.Format, "Parse error")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 0, 0, time.UTC).Unix(), r.Time.Unix(), "Parse error")
+
+	t, err = p.ParseOrdered("01/02/2006 15:04")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 2, 20, 6, 15, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetEnabledFormats()
+
+	t, err = p.ParseOrdered("2006-01-02T15:04:05Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestParseAppend(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	dst := make([]time.Time, 0, 3)
+	dst = append(dst, time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC))
+
+	dst, err := p.ParseAppend(dst, "2024-01-15T00:00:00Z", "Jan 16 2024 00:00:00 UTC")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(3, len(dst), "Parse error")
+	for i, t := range dst {
+		assert.Equal(time.Date(2024, 1, 14+i, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+	}
+
+	dst, err = p.ParseAppend(dst[:0], "2024-01-15T00:00:00Z", "garbage", "2024-01-17T00:00:00Z")
+	assert.True(errors.Is(err, errInvalidDateTime), "Invalid date/time")
+	assert.Equal("Value 1: Invalid date/time", err.Error(), "Incorrect error")
+	assert.Equal([]time.Time{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}, dst, "Parse error")
+}
+
+var benchmarkValues = []string{
+	"2006-01-02T15:04:05+09:00",
+	"2006-01-02T15:04:05.999999999Z",
+	"2006-01-02T15:04:05-07:00",
+}
+
+func BenchmarkParseAppend(b *testing.B) {
+	p, _ := NewParseTime(time.UTC)
+	dst := make([]time.Time, 0, len(benchmarkValues))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst, _ = p.ParseAppend(dst[:0], benchmarkValues...)
+	}
+}
+
+func BenchmarkParseFreshSlice(b *testing.B) {
+	p, _ := NewParseTime(time.UTC)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var times []time.Time
+		for _, value := range benchmarkValues {
+			t, _ := p.Parse(value)
+			times = append(times, t)
+		}
+	}
+}
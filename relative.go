@@ -0,0 +1,60 @@
+package parsetime
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	reRelativeDay  = regexp.MustCompile(`(?i)^(\s*(today|tomorrow|yesterday)\s+)(.+)$`)
+	reHourAMPM     = regexp.MustCompile(hourAMPMPattern())
+	reRelativeTime = regexp.MustCompile(relativeTimePattern())
+)
+
+// relativeDays is the number of days from today of the relative day keywords
+var relativeDays = map[string]int{
+	"today":     0,
+	"tomorrow":  1,
+	"yesterday": -1,
+}
+
+func hourAMPMPattern() string {
+	return `^\s*` + hour + s + ampmPattern() + `\s*$`
+}
+
+// relativeTimePattern matches a time without a date (e.g. "9am", "9:15 PM", "00:00:01 -07:00", "1430")
+// after a relative day keyword, which may be followed by text without digits
+func relativeTimePattern() string {
+	return `^\s*` + hour + `(?:[:.]?` + min + `(?:[:.]?` + sec + nsec + `)?)?` + s + ampmPattern() + `?` + s + usOffsetZone + `[^0-9]*$`
+}
+
+// splitRelativeDay splits a leading relative day keyword (e.g. "tomorrow 9am") from value
+func splitRelativeDay(value string) (int, string, string, bool) {
+	group := reRelativeDay.FindStringSubmatch(value)
+	if len(group) == 0 {
+		return 0, "", value, false
+	}
+
+	return relativeDays[strings.ToLower(group[2])], group[1], group[3], true
+}
+
+// expandHourAMPM rewrites an hour with AM/PM (e.g. "9am") to a time that the parsers match (e.g. "9:00am")
+func expandHourAMPM(value string) string {
+	group := registered(&reHourAMPM).FindStringSubmatch(value)
+	if len(group) == 0 {
+		return value
+	}
+
+	return group[1] + ":00" + group[2]
+}
+
+// withRelativeDay replaces the date of dt with the date days from today
+func (pt *ParseTime) withRelativeDay(dt dateTime, days int) dateTime {
+	year, month, day := pt.now().In(dt.loc).AddDate(0, 0, days).Date()
+
+	dt.year = year
+	dt.month = int(month)
+	dt.day = day
+
+	return dt
+}
@@ -0,0 +1,46 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRelativeDay(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)))
+
+	times := []TestTime{
+		{
+			Value: "today 14:30",
+			Time:  time.Date(2024, 1, 31, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "tomorrow 9am",
+			Time:  time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Yesterday 9:15 PM",
+			Time:  time.Date(2024, 1, 30, 21, 15, 0, 0, time.UTC),
+		},
+		{
+			Value: "tomorrow 00:00:01 -07:00",
+			Time:  time.Date(2024, 2, 1, 0, 0, 1, 0, time.FixedZone("", -7*3600)),
+		},
+	}
+
+	for _, tt := range times {
+		result, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), result.Time.Unix(), "Parse error: "+tt.Value)
+		assert.Equal("", result.Leftover, "Leftover error: "+tt.Value)
+	}
+
+	for _, value := range []string{"tomorrow", "tomorrow 2024-01-15 10:00", "yesterday Jan 15 9am"} {
+		_, err := p.Parse(value)
+		assert.Equal(errInvalidDateTime, err, "Invalid date/time: "+value)
+	}
+}
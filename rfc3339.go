@@ -0,0 +1,106 @@
+package parsetime
+
+// fastRFC3339 parses the canonical RFC3339 form without fractional seconds (2006-01-02T15:04:05Z, 2006-01-02T15:04:05-07:00)
+// without the regular expressions. It reports false for any other value, which is left to the ISO8601 format.
+func (pt *ParseTime) fastRFC3339(value string) (dateTime, bool) {
+	var dt dateTime
+
+	if len(value) != 20 && len(value) != 25 {
+		return dt, false
+	}
+
+	if value[4] != '-' || value[7] != '-' || value[10] != 'T' || value[13] != ':' || value[16] != ':' {
+		return dt, false
+	}
+
+	year, ok := atoiDigits(value[0:4])
+	// years after 2999 are not matched by ISO8601
+	if !ok || year > 2999 {
+		return dt, false
+	}
+
+	month, ok := atoiDigits(value[5:7])
+	if !ok || month < 1 || month > 12 {
+		return dt, false
+	}
+
+	day, ok := atoiDigits(value[8:10])
+	if !ok || day < 1 || day > pt.daysIn(year, month) {
+		return dt, false
+	}
+
+	hour, ok := atoiDigits(value[11:13])
+	if !ok || hour > 23 {
+		return dt, false
+	}
+
+	min, ok := atoiDigits(value[14:16])
+	if !ok || min > 59 {
+		return dt, false
+	}
+
+	sec, ok := atoiDigits(value[17:19])
+	if !ok || sec > 59 {
+		return dt, false
+	}
+
+	zone := value[19:]
+	if !isFastRFC3339Zone(zone) {
+		return dt, false
+	}
+
+	loc, err := pt.toLocation(zone)
+	if err != nil {
+		return dt, false
+	}
+
+	offset, abbr := splitZone(zone)
+
+	return dateTime{
+		year:    year,
+		month:   month,
+		day:     day,
+		hour:    hour,
+		min:     min,
+		sec:     sec,
+		loc:     loc,
+		offset:  offset,
+		abbr:    abbr,
+		matched: value,
+		format:  "ISO8601",
+	}, true
+}
+
+// isFastRFC3339Zone reports whether zone is "Z" or an offset matched by ISO8601 ("+09:00")
+func isFastRFC3339Zone(zone string) bool {
+	if zone == "Z" {
+		return true
+	}
+
+	if len(zone) != 6 || (zone[0] != '+' && zone[0] != '-') || zone[3] != ':' {
+		return false
+	}
+
+	// the offset of ISO8601 is -14:00 to +14:00
+	hours, ok := atoiDigits(zone[1:3])
+	if !ok || hours > 14 {
+		return false
+	}
+
+	_, ok = atoiDigits(zone[4:6])
+	return ok
+}
+
+// atoiDigits converts the ASCII digits to int, and reports false if value has any other character
+func atoiDigits(value string) (int, bool) {
+	var n int
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+
+	return n, true
+}
@@ -0,0 +1,95 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var canonicalRFC3339Times = []string{
+	"2006-01-02T15:04:05Z",
+	"2006-01-02T15:04:05+09:00",
+	"2006-01-02T15:04:05-07:00",
+	"2024-02-29T23:59:59+05:30",
+	"2006-01-02T15:04:05+00:00",
+	"2006-01-02T15:04:05+10:00",
+	"2006-01-02T15:04:05+14:00",
+	"1970-01-01T00:00:00Z",
+	"1582-10-04T12:00:00Z",
+}
+
+func TestFastRFC3339(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	for _, value := range canonicalRFC3339Times {
+		dt, ok := p.fastRFC3339(value)
+		assert.Equal(true, ok, "Invalid date/time: "+value)
+
+		isoDt, _, err := p.parseISO8601(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+
+		t, err := p.toTime(dt)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		isoT, _ := p.toTime(isoDt)
+		assert.Equal(isoT.String(), t.String(), "Parse error: "+value)
+		assert.Equal(isoDt.zone(), dt.zone(), "Parse error: "+value)
+		assert.Equal(isoDt.matched, dt.matched, "Parse error: "+value)
+	}
+
+	regexp, _ := NewParseTime(time.UTC)
+	regexp.SetFormatWeight("ISO8601", 0)
+
+	for _, value := range canonicalRFC3339Times {
+		r, err := p.ParseDetailed(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		regexpR, _ := regexp.ParseDetailed(value)
+		assert.Equal(regexpR.Time.String(), r.Time.String(), "Parse error: "+value)
+		assert.Equal(regexpR.Format, r.Format, "Parse error: "+value)
+		assert.Equal(regexpR.ExplicitZone, r.ExplicitZone, "Parse error: "+value)
+		assert.Equal(regexpR.Leftover, r.Leftover, "Parse error: "+value)
+	}
+
+	// offsets out of range give the same result on both paths
+	for _, value := range []string{"2006-01-02T15:04:05+15:00", "2006-01-02T15:04:05+19:00"} {
+		r, err := p.ParseDetailed(value)
+		regexpR, regexpErr := regexp.ParseDetailed(value)
+		assert.Equal(regexpErr, err, "Parse error: "+value)
+		assert.Equal(regexpR.Time.String(), r.Time.String(), "Parse error: "+value)
+		assert.Equal(regexpR.Format, r.Format, "Parse error: "+value)
+	}
+
+	for _, value := range []string{
+		"2006-01-02T15:04:05.999Z",
+		"2006-01-02 15:04:05Z",
+		"2006-01-02T15:04:05+15:00",
+		"2006-01-02T15:04:05+19:00",
+		"2006-01-02T15:04:60Z",
+		"3000-01-02T15:04:05Z",
+		"2006-13-02T15:04:05Z",
+		"2006-01-02T15:04:05z",
+	} {
+		_, ok := p.fastRFC3339(value)
+		assert.Equal(false, ok, "Parse error: "+value)
+	}
+}
+
+func BenchmarkParseRFC3339(b *testing.B) {
+	p, _ := NewParseTime(time.UTC)
+
+	for i := 0; i < b.N; i++ {
+		p.Parse("2006-01-02T15:04:05+09:00")
+	}
+}
+
+func BenchmarkParseRFC3339Regexp(b *testing.B) {
+	p, _ := NewParseTime(time.UTC)
+	// changing the weights disables fastRFC3339
+	p.SetFormatWeight("ISO8601", 0)
+
+	for i := 0; i < b.N; i++ {
+		p.Parse("2006-01-02T15:04:05+09:00")
+	}
+}
@@ -0,0 +1,47 @@
+package parsetime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// reISOFraction matches the decimal fraction of the lowest order time component of ISO8601 (2024-01-15T14.5, 2024-01-15T14:30.5)
+var reISOFraction = regexp.MustCompile(`([0-9]{4}-?[0-9]{2}-?[0-9]{2}[tT])([01][0-9]|2[0-3])(?::?([0-5][0-9]))?[.,]([0-9]+)`)
+
+// SetStrict sets whether date/time strings are parsed strictly by the standards.
+// In strict mode, the decimal fraction of ISO8601 hours and minutes is converted into lower units (e.g. "14.5" -> 14:30:00, "14:30.5" -> 14:30:30),
+// and a timezone abbreviation that does not match the numeric offset (e.g. "+09:00 PST") or a second conflicting offset (e.g. "Z+09:00") is an error.
+func (pt *ParseTime) SetStrict(strict bool) *ParseTime {
+	pt.strict = strict
+
+	return pt
+}
+
+// replaceISOFraction converts the decimal fraction of ISO8601 hours and minutes into hours, minutes, seconds and nanoseconds
+func replaceISOFraction(value string) string {
+	return reISOFraction.ReplaceAllStringFunc(value, func(fraction string) string {
+		group := reISOFraction.FindStringSubmatch(fraction)
+
+		hour, _ := strconv.Atoi(group[2])
+		d := time.Duration(hour) * time.Hour
+		unit := time.Hour
+		if group[3] != "" {
+			min, _ := strconv.Atoi(group[3])
+			d += time.Duration(min) * time.Minute
+			unit = time.Minute
+		}
+
+		// billionths of the unit
+		billionths, _ := fractionToNsec(group[4])
+		d += unit / time.Second * time.Duration(billionths)
+
+		replaced := fmt.Sprintf("%s%02d:%02d:%02d", group[1], d/time.Hour, d/time.Minute%60, d/time.Second%60)
+		if nsec := d % time.Second; nsec != 0 {
+			replaced += fmt.Sprintf(".%09d", nsec)
+		}
+
+		return replaced
+	})
+}
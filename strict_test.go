@@ -0,0 +1,89 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetStrictISO8601Fraction(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetStrict(true)
+
+	times := []TestTime{
+		{Value: "2024-01-15T14.5", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)},
+		{Value: "2024-01-15T14:30.5", Time: time.Date(2024, 1, 15, 14, 30, 30, 0, time.UTC)},
+		{Value: "2024-01-15T14:30,5Z", Time: time.Date(2024, 1, 15, 14, 30, 30, 0, time.UTC)},
+		{Value: "2024-01-15T14,25+09:00", Time: time.Date(2024, 1, 15, 14, 15, 0, 0, time.FixedZone("", 9*3600))},
+		{Value: "20240115T1430.25Z", Time: time.Date(2024, 1, 15, 14, 30, 15, 0, time.UTC)},
+		{Value: "2024-01-15T14.001", Time: time.Date(2024, 1, 15, 14, 0, 3, 600000000, time.UTC)},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.UnixNano(), t.UnixNano(), "Parse error: "+tt.Value)
+	}
+
+	p.SetStrict(false)
+
+	t, err := p.Parse("2024-01-15T14:30.5")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 5, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestOffsetWithAbbreviation(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{Value: "2024-01-15T14:30:00+09:00 JST", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600))},
+		{Value: "2024-01-15T14:30:00-08:00 PST", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", -8*3600))},
+		{Value: "2024-01-15T14:30:00+00:00 UTC", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)},
+		{Value: "2024-01-15T14:30:00+10:00 AEST", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 10*3600))},
+		{Value: "2024-01-15 14:30:00 +09:00 JST", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600))},
+		// the offset is preferred
+		{Value: "2024-01-15T14:30:00+09:00 PST", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600))},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	p.SetStrict(true)
+
+	t, err := p.Parse("2024-01-15T14:30:00+09:00 JST")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 5, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	_, err = p.Parse("2024-01-15T14:30:00+09:00 PST")
+	assert.Equal(errZoneMismatch, err, "Parse error")
+}
+
+func TestConflictingOffsets(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	// the first offset is used
+	t, err := p.Parse("2024-01-15T14:30:00Z+09:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetStrict(true)
+
+	for _, value := range []string{"2024-01-15T14:30:00Z+09:00", "2024-01-15T14:30:00+09:00 -05:00"} {
+		_, err = p.Parse(value)
+		assert.Equal(errConflictingOffsets, err, "Parse error: "+value)
+	}
+
+	t, err = p.Parse("2024-01-15T14:30:00+09:00+09:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 5, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
@@ -0,0 +1,66 @@
+package parsetime
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// reTimeOnly matches a time of day with colons (14:30, 14:30:05) or 4 or 6 digits without separators (1430, 143005)
+var reTimeOnly = regexp.MustCompile(`^(?:(2[0-3]|[01]?[0-9]):([0-5][0-9])(?::(60|[0-5][0-9]))?|(2[0-3]|[01][0-9])([0-5][0-9])(60|[0-5][0-9])?)(?:[.,]([0-9]{1,9}))?\s*(Z|[+-][0-9]{2}:?[0-9]{2})?$`)
+
+func (pt *ParseTime) parseTimeOnly(value string) (dateTime, int, error) {
+	var dt dateTime
+	var priority int
+	var err error
+	loc := pt.location
+
+	group := findSubmatch(reTimeOnly, strings.TrimSpace(value), 8)
+
+	if len(group) == 0 {
+		return dt, priority, errInvalidDateTime
+	}
+
+	// 1430, 143005
+	if group[4] != "" {
+		group[1], group[2], group[3] = group[4], group[5], group[6]
+	}
+
+	if group[8] != "" {
+		loc, err = pt.toLocation(group[8])
+		if err != nil {
+			return dt, priority, err
+		}
+	}
+
+	dates := []string{group[1], group[2], group[3], group[7]}
+
+	var fields [4]int
+	for i, dateType := range []string{"hour", "min", "sec", "nsec"} {
+		fields[i], err = pt.dateToInt(dates[i], dateType, loc)
+		if err != nil {
+			return dt, priority, err
+		}
+	}
+
+	now := pt.now().In(loc)
+
+	return dateTime{
+		year:    now.Year(),
+		month:   int(now.Month()),
+		day:     now.Day(),
+		hour:    fields[0],
+		min:     fields[1],
+		sec:     fields[2],
+		nsec:    fields[3],
+		loc:     loc,
+		offset:  group[8],
+		matched: group[0],
+	}, priority, nil
+}
+
+// TimeOnly parses a time of day (15:04, 15:04:05, 1504, 150405) with optional fractional seconds and offset.
+// The date is taken from the clock.
+func (pt *ParseTime) TimeOnly(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseTimeOnly, value)
+}
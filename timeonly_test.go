@@ -0,0 +1,66 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeOnly(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)))
+
+	times := []TestTime{
+		{
+			Value: "1430",
+			Time:  time.Date(2024, 3, 10, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "143005",
+			Time:  time.Date(2024, 3, 10, 14, 30, 5, 0, time.UTC),
+		},
+		{
+			Value: "0930",
+			Time:  time.Date(2024, 3, 10, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "143005.25",
+			Time:  time.Date(2024, 3, 10, 14, 30, 5, 250000000, time.UTC),
+		},
+		{
+			Value: "1430Z",
+			Time:  time.Date(2024, 3, 10, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "143005+0900",
+			Time:  time.Date(2024, 3, 10, 14, 30, 5, 0, time.FixedZone("", 9*3600)),
+		},
+		{
+			Value: "14:30",
+			Time:  time.Date(2024, 3, 10, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Value: "9:30:05",
+			Time:  time.Date(2024, 3, 10, 9, 30, 5, 0, time.UTC),
+		},
+		{
+			Value: "14:30:05 +09:00",
+			Time:  time.Date(2024, 3, 10, 14, 30, 5, 0, time.FixedZone("", 9*3600)),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.TimeOnly(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+		assert.Equal(tt.Time.Nanosecond(), t.Nanosecond(), "Parse error: "+tt.Value)
+	}
+
+	for _, value := range []string{"930", "12345", "1234567", "2430", "1460", "143075", "20240115"} {
+		_, err := p.TimeOnly(value)
+		assert.NotEqual(nil, err, "Parse error: "+value)
+	}
+}
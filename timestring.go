@@ -0,0 +1,64 @@
+package parsetime
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// reTimeString matches the output of time.Time.String (2006-01-02 15:04:05.999999999 -0700 MST m=+0.000000001)
+var reTimeString = regexp.MustCompile(`([0-9]{4})-([0-9]{2})-([0-9]{2}) ([0-9]{2}):([0-9]{2}):([0-9]{2})(?:[.]([0-9]{1,9}))? ([+-])([0-9]{2})([0-9]{2}) ([a-zA-Z0-9+-]+)(?: m=[+-][0-9]+[.][0-9]+)?`)
+
+func (pt *ParseTime) parseTimeString(value string) (dateTime, int, error) {
+	var dt dateTime
+	var priority int
+
+	group := findSubmatch(reTimeString, value, 11)
+
+	if len(group) == 0 {
+		return dt, priority, errInvalidDateTime
+	}
+
+	priority = stringLen(value) - stringLen(group[0])
+
+	var fields [6]int
+	for i := range fields {
+		n, err := strconv.Atoi(group[i+1])
+		if err != nil {
+			return dt, priority, err
+		}
+		fields[i] = n
+	}
+
+	nsec, err := fractionToNsec(group[7])
+	if err != nil {
+		return dt, priority, err
+	}
+
+	hours, _ := strconv.Atoi(group[9])
+	mins, _ := strconv.Atoi(group[10])
+	offset := hours*3600 + mins*60
+	if group[8] == "-" {
+		offset = -offset
+	}
+
+	// the numeric offset is preferred over the abbreviation, which may be ambiguous
+	return dateTime{
+		year:    fields[0],
+		month:   fields[1],
+		day:     fields[2],
+		hour:    fields[3],
+		min:     fields[4],
+		sec:     fields[5],
+		nsec:    nsec,
+		loc:     time.FixedZone(group[11], offset),
+		offset:  group[8] + group[9] + group[10],
+		abbr:    group[11],
+		matched: group[0],
+	}, priority, nil
+}
+
+// TimeString parses the output of time.Time.String (e.g. "2006-01-02 15:04:05.999999999 -0700 MST")
+func (pt *ParseTime) TimeString(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseTimeString, value)
+}
@@ -0,0 +1,41 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeString(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	mst := time.FixedZone("MST", -7*3600)
+	jst := time.FixedZone("JST", 9*3600)
+
+	for _, want := range []time.Time{
+		time.Now(),
+		time.Now().In(jst),
+		time.Date(2006, 1, 2, 15, 4, 5, 123456700, mst),
+		time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*3600)),
+		time.Date(2006, 1, 2, 15, 4, 5, 999999999, time.UTC),
+	} {
+		value := want.String()
+
+		t, err := p.TimeString(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(want.UnixNano(), t.UnixNano(), "Parse error: "+value)
+		assert.Equal(getOffset(want), getOffset(t), "Offset error: "+value)
+
+		t, err = p.Parse(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+		assert.Equal(want.UnixNano(), t.UnixNano(), "Parse error: "+value)
+	}
+
+	// the numeric offset is preferred over the abbreviation
+	t, err := p.TimeString("2006-01-02 15:04:05 +0900 CST")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 15, 4, 5, 0, jst).Unix(), t.Unix(), "Parse error")
+}
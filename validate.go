@@ -0,0 +1,210 @@
+package parsetime
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	errFutureDateTime     = errors.New("Future date/time")
+	errOutOfRangeDateTime = errors.New("Date/time out of range")
+	errMissingTimezone    = errors.New("Missing timezone")
+	errNotBusinessDay     = errors.New("Not a business day")
+	errZoneMismatch       = errors.New("Offset and timezone do not match")
+	errConflictingOffsets = errors.New("Conflicting offsets")
+	errWeekdayMismatch    = errors.New("Weekday does not match the date")
+
+	// reLeadingWord matches a leading word followed by a comma, period or spaces ("Mon, ", "lundi ")
+	reLeadingWord = regexp.MustCompile(`^\s*([^\s,.0-9]+)(?:[,.]\s*|\s+)`)
+)
+
+// SetValidateWeekday sets whether a leading weekday name (e.g. "Mon, 15 Jan 2024") must be the weekday of the parsed date.
+// Names of SetWeekdayNames that are not English (e.g. "lundi 2024-01-15") are removed before parsing.
+func (pt *ParseTime) SetValidateWeekday(validate bool) *ParseTime {
+	pt.validateWeekday = validate
+
+	return pt
+}
+
+// SetWeekdayNames sets the weekday names used by SetValidateWeekday instead of the English names of Weekdays (e.g. "lundi": time.Monday).
+// The names are case-insensitive.
+func (pt *ParseTime) SetWeekdayNames(names map[string]time.Weekday) *ParseTime {
+	pt.weekdayNames = make(map[string]time.Weekday, len(names))
+	for name, weekday := range names {
+		pt.weekdayNames[strings.ToLower(name)] = weekday
+	}
+
+	return pt
+}
+
+// splitWeekday splits a leading weekday name from value,
+// and returns the rest, the removed prefix and the weekday (e.g. "lundi 2024-01-15" -> "2024-01-15", "lundi ", time.Monday).
+// English names are left in value, as the parsers match them.
+func (pt *ParseTime) splitWeekday(value string) (string, string, time.Weekday, bool) {
+	group := reLeadingWord.FindStringSubmatch(value)
+	if len(group) == 0 {
+		return value, "", time.Sunday, false
+	}
+
+	names := pt.weekdayNames
+	if names == nil {
+		names = Weekdays
+	}
+
+	name := strings.ToLower(group[1])
+	weekday, ok := names[name]
+	if !ok {
+		return value, "", time.Sunday, false
+	}
+
+	if _, ok := Weekdays[name]; ok {
+		return value, "", weekday, true
+	}
+
+	return value[len(group[0]):], group[0], weekday, true
+}
+
+// checkWeekday checks that the weekday name in the input is the weekday of t
+func (pt *ParseTime) checkWeekday(dt dateTime, t time.Time) error {
+	if dt.weekday != nil && *dt.weekday != t.Weekday() {
+		return errWeekdayMismatch
+	}
+
+	return nil
+}
+
+// SetRejectFuture sets whether a parsed time after the current time of the Clock is an error
+func (pt *ParseTime) SetRejectFuture(reject bool) *ParseTime {
+	pt.rejectFuture = reject
+
+	return pt
+}
+
+// SetValidRange sets the range [min, max] of parsed times, outside of which is an error.
+// A zero min or max means the range is unbounded on that side.
+func (pt *ParseTime) SetValidRange(min, max time.Time) *ParseTime {
+	pt.validMin = min
+	pt.validMax = max
+
+	return pt
+}
+
+// SetRequireTimezone sets whether a date/time string without offset/timezone is an error instead of using the parser's location.
+// Unix time is always UTC.
+func (pt *ParseTime) SetRequireTimezone(require bool) *ParseTime {
+	pt.requireTimezone = require
+
+	return pt
+}
+
+// checkTimezone checks that the date/time string has offset/timezone if it is required
+func (pt *ParseTime) checkTimezone(dt dateTime) error {
+	if pt.requireTimezone && dt.zone() == "" && !isUTCFormat(dt.format) {
+		return errMissingTimezone
+	}
+
+	return nil
+}
+
+// checkZoneAgreement checks in strict mode that the timezone abbreviation can have the numeric offset of t (e.g. "+09:00 JST"),
+// and that a second numeric offset is the same as the offset of t (e.g. "Z+09:00" is an error).
+// An abbreviation that can not be resolved is ignored, as the numeric offset is preferred.
+func (pt *ParseTime) checkZoneAgreement(dt dateTime, t time.Time) error {
+	if !pt.strict {
+		return nil
+	}
+
+	if dt.extraOffset != "" {
+		loc, err := pt.toLocation(dt.extraOffset)
+		if err != nil {
+			return err
+		}
+
+		_, offset := t.Zone()
+		if _, extra := t.In(loc).Zone(); extra != offset {
+			return errConflictingOffsets
+		}
+	}
+
+	if dt.offset == "" || dt.abbr == "" {
+		return nil
+	}
+
+	offsets := abbrOffsets(dt.abbr)
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	_, offset := t.Zone()
+	for _, o := range offsets {
+		if o == offset {
+			return nil
+		}
+	}
+
+	return errZoneMismatch
+}
+
+// isUTCFormat reports whether the format is always UTC (e.g. Unix time)
+func isUTCFormat(format string) bool {
+	switch format {
+	case "Epoch", "AppleEpoch", "FileTime":
+		return true
+	}
+
+	return false
+}
+
+// validate checks the parsed time against the validation options
+func (pt *ParseTime) validate(t time.Time) error {
+	if pt.rejectFuture && t.After(pt.now()) {
+		return errFutureDateTime
+	}
+
+	if (!pt.validMin.IsZero() && t.Before(pt.validMin)) || (!pt.validMax.IsZero() && t.After(pt.validMax)) {
+		return errOutOfRangeDateTime
+	}
+
+	return nil
+}
+
+// SetHolidays sets the dates that are not business days for ParseBusinessDay.
+// Only the year, month and day of the holidays are compared.
+func (pt *ParseTime) SetHolidays(holidays []time.Time) *ParseTime {
+	pt.holidays = append([]time.Time{}, holidays...)
+
+	return pt
+}
+
+// isBusinessDay reports whether the date of t is neither a weekend (Saturday, Sunday) nor a holiday
+func (pt *ParseTime) isBusinessDay(t time.Time) bool {
+	if weekday := t.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return false
+	}
+
+	year, month, day := t.Date()
+	for _, holiday := range pt.holidays {
+		y, m, d := holiday.Date()
+		if y == year && m == month && d == day {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseBusinessDay parses date/time string like Parse, and returns an error if it is on a weekend or a holiday set by SetHolidays
+func (pt *ParseTime) ParseBusinessDay(value string) (time.Time, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return t, err
+	}
+
+	if !pt.isBusinessDay(t) {
+		return time.Time{}, errNotBusinessDay
+	}
+
+	return t, nil
+}
@@ -0,0 +1,181 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetRejectFuture(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)))
+
+	t, err := p.Parse("2100-01-01T00:00:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetRejectFuture(true)
+
+	_, err = p.Parse("2100-01-01T00:00:00Z")
+	assert.Equal(errFutureDateTime, err, "Future date/time")
+
+	_, err = p.ISO8601("2024-01-15T14:30:01Z")
+	assert.Equal(errFutureDateTime, err, "Future date/time")
+
+	for _, value := range []string{"1990-05-17", "2024-01-15T14:30:00Z", "2024-01-15T23:00:00+09:00"} {
+		_, err = p.Parse(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+	}
+}
+
+func TestSetValidRange(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetValidRange(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	for _, value := range []string{"2024-01-15T14:30:00Z", "1900-01-01T00:00:00Z", "2100-01-01T00:00:00Z"} {
+		_, err := p.Parse(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+	}
+
+	for _, value := range []string{"0001-01-01T00:00:00Z", "1899-12-31T23:59:59Z", "2100-01-01T00:00:01Z", "2999-12-31T00:00:00Z"} {
+		_, err := p.Parse(value)
+		assert.Equal(errOutOfRangeDateTime, err, "Date/time out of range: "+value)
+	}
+
+	// unbounded max
+	p.SetValidRange(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+
+	_, err := p.Parse("2999-12-31T00:00:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+
+	_, err = p.Parse("0001-01-01T00:00:00Z")
+	assert.Equal(errOutOfRangeDateTime, err, "Date/time out of range")
+
+	// unbounded min
+	p.SetValidRange(time.Time{}, time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	_, err = p.Parse("0001-01-01T00:00:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+}
+
+func TestSetRequireTimezone(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.Parse("2024-01-15 14:30:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetRequireTimezone(true)
+
+	for _, value := range []string{"2024-01-15 14:30:00", "2024-01-15T14:30:00", "01/15/2024 2:30 PM", "15 Jan 2024 14:30"} {
+		_, err = p.Parse(value)
+		assert.Equal(errMissingTimezone, err, "Missing timezone: "+value)
+	}
+
+	_, err = p.ISO8601("2024-01-15T14:30:00")
+	assert.Equal(errMissingTimezone, err, "Missing timezone")
+
+	for _, value := range []string{"2024-01-15T14:30:00Z", "2024-01-15 14:30:00 -07:00", "Mon, 15 Jan 2024 14:30:00 JST", "01/15/2024 2:30 PM PST", "1705329000"} {
+		_, err = p.Parse(value)
+		assert.Equal(nil, err, "Invalid date/time: "+value)
+	}
+}
+
+func TestParseBusinessDay(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.ParseBusinessDay("2024-01-15")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	for _, value := range []string{"2024-01-13", "Sun, 14 Jan 2024 10:00:00 +0000"} {
+		_, err = p.ParseBusinessDay(value)
+		assert.Equal(errNotBusinessDay, err, "Not a business day: "+value)
+	}
+
+	p.SetHolidays([]time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	})
+
+	for _, value := range []string{"2024-01-01", "2024-01-15T14:30:00Z", "01/15/2024 9:00 PM"} {
+		_, err = p.ParseBusinessDay(value)
+		assert.Equal(errNotBusinessDay, err, "Not a business day: "+value)
+	}
+
+	t, err = p.ParseBusinessDay("2024-01-16")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	_, err = p.ParseBusinessDay("garbage")
+	assert.NotEqual(nil, err, "Parse error")
+}
+
+func TestSetValidateWeekday(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetValidateWeekday(true)
+
+	times := []TestTime{
+		{Value: "Mon, 15 Jan 2024 14:30:00 +0000", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)},
+		{Value: "Mon Jan 15 14:30:00 2024", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)},
+		{Value: "Monday, 01/15/2024", Time: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{Value: "2024-01-15", Time: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	_, err := p.Parse("Tue, 15 Jan 2024 14:30:00 +0000")
+	assert.Equal(errWeekdayMismatch, err, "Parse error")
+
+	p.SetValidateWeekday(false)
+
+	_, err = p.Parse("Tue, 15 Jan 2024 14:30:00 +0000")
+	assert.Equal(nil, err, "Invalid date/time")
+}
+
+func TestSetWeekdayNames(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetValidateWeekday(true).SetWeekdayNames(map[string]time.Weekday{
+		"dimanche": time.Sunday,
+		"lundi":    time.Monday,
+		"mardi":    time.Tuesday,
+		"mercredi": time.Wednesday,
+		"jeudi":    time.Thursday,
+		"vendredi": time.Friday,
+		"samedi":   time.Saturday,
+	})
+
+	times := []TestTime{
+		{Value: "lundi 2024-01-15", Time: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{Value: "Lundi, 15/01/2024 14:30", Time: time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)},
+		{Value: "dimanche 2024-01-21T09:00:00Z", Time: time.Date(2024, 1, 21, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	for _, value := range []string{"mardi 2024-01-15", "Vendredi, 15/01/2024 14:30"} {
+		_, err := p.Parse(value)
+		assert.Equal(errWeekdayMismatch, err, "Parse error: "+value)
+	}
+}
@@ -0,0 +1,59 @@
+package parsetime
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// reNumericDate matches a date with numeric day and month (01/02/2024, 01.02.24)
+var reNumericDate = regexp.MustCompile(`(?:^|[^0-9])[0-9]{1,2}[/.-][0-9]{1,2}[/.-][0-9]{2,4}(?:[^0-9]|$)`)
+
+// ParseWithWarnings parses date/time string leniently like Parse with SetLenient(true),
+// and returns warnings about the heuristics applied to the input (e.g. "assumed month-first", "defaulted year to 2024", "ignored trailing text")
+func (pt *ParseTime) ParseWithWarnings(value string) (time.Time, []string, error) {
+	p := *pt
+	p.lenient = true
+
+	dt, err := p.parse(value)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	t, err := p.toTime(dt)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return t, p.warnings(value, dt), nil
+}
+
+// warnings returns the heuristics applied to value parsed as dt
+func (pt *ParseTime) warnings(value string, dt dateTime) []string {
+	var warnings []string
+
+	// 01/02/2024 is January 2 in US and February 1 in RFC8xx1123
+	if reNumericDate.MatchString(dt.matched) && dt.month <= 12 && dt.day <= 12 && dt.month != dt.day {
+		switch dt.format {
+		case "US":
+			warnings = append(warnings, "assumed month-first")
+		case "RFC8xx1123":
+			warnings = append(warnings, "assumed day-first")
+		}
+	}
+
+	if dt.yearMissing {
+		warnings = append(warnings, fmt.Sprintf("defaulted year to %d", pt.inferYear(dt)))
+	}
+
+	if dt.zone() == "" && !isUTCFormat(dt.format) && !pt.discardInputZone {
+		warnings = append(warnings, fmt.Sprintf("assumed timezone %s", dt.loc))
+	}
+
+	if leftover := strings.TrimSpace(strings.Replace(pt.prepare(value), dt.matched, "", 1)); leftover != "" {
+		warnings = append(warnings, fmt.Sprintf("ignored trailing text %q", leftover))
+	}
+
+	return warnings
+}
@@ -0,0 +1,70 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWithWarnings(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+
+	tests := []struct {
+		value    string
+		time     time.Time
+		warnings []string
+	}{
+		{
+			value:    "2024-01-15T14:30:00Z",
+			time:     time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+			warnings: nil,
+		},
+		{
+			value:    "01/02/2024",
+			time:     time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			warnings: []string{"assumed day-first", "assumed timezone UTC"},
+		},
+		{
+			value:    "13/02/2024",
+			time:     time.Date(2024, 2, 13, 0, 0, 0, 0, time.UTC),
+			warnings: []string{"assumed timezone UTC"},
+		},
+		{
+			value:    "Jan 15 14:30:00 +09:00",
+			time:     time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600)),
+			warnings: []string{"defaulted year to 2024"},
+		},
+		{
+			value:    "Mon Jan 15 14:30:00 2024 foo",
+			time:     time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+			warnings: []string{"assumed timezone UTC", `ignored trailing text "foo"`},
+		},
+		{
+			value:    "1705329000",
+			time:     time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+			warnings: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t, warnings, err := p.ParseWithWarnings(tt.value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.value)
+		assert.Equal(tt.time.Unix(), t.Unix(), "Parse error: "+tt.value)
+		assert.Equal(tt.warnings, warnings, "Warnings error: "+tt.value)
+	}
+
+	p.SetEnabledFormats("US")
+
+	t, warnings, err := p.ParseWithWarnings("01/02/2024")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+	assert.Equal([]string{"assumed month-first", "assumed timezone UTC"}, warnings, "Warnings error")
+
+	_, warnings, err = p.ParseWithWarnings("foo")
+	assert.NotEqual(nil, err, "Parse error")
+	assert.Nil(warnings, "Warnings error")
+}
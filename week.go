@@ -0,0 +1,167 @@
+package parsetime
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var reWeek = regexp.MustCompile(`(?i)week\s*([0-9]{1,2})\s*,?\s*([a-z]+)\s*,?\s*([0-9]{4})`)
+
+// Weekdays maps weekday names to time.Weekday
+var Weekdays = map[string]time.Weekday{
+	"sun":       time.Sunday,
+	"sunday":    time.Sunday,
+	"mon":       time.Monday,
+	"monday":    time.Monday,
+	"tue":       time.Tuesday,
+	"tuesday":   time.Tuesday,
+	"wed":       time.Wednesday,
+	"wednesday": time.Wednesday,
+	"thu":       time.Thursday,
+	"thursday":  time.Thursday,
+	"fri":       time.Friday,
+	"friday":    time.Friday,
+	"sat":       time.Saturday,
+	"saturday":  time.Saturday,
+}
+
+// WeekNumbering is the convention used to number the weeks of a year
+type WeekNumbering int
+
+const (
+	// WeekNumberingISO numbers weeks from Monday, week 1 is the week with the year's first Thursday
+	WeekNumberingISO WeekNumbering = iota
+	// WeekNumberingUS numbers weeks from the week start (Sunday by default, see SetWeekStart), week 1 is the week with January 1
+	WeekNumberingUS
+)
+
+// SetWeekNumbering sets the convention used to number the weeks of a year
+func (pt *ParseTime) SetWeekNumbering(numbering WeekNumbering) *ParseTime {
+	pt.weekNumbering = numbering
+
+	return pt
+}
+
+// SetWeekStart sets the first day of the week (default time.Sunday) for WeekNumberingUS.
+// ISO weeks always start on Monday, so it does not affect WeekNumberingISO.
+func (pt *ParseTime) SetWeekStart(weekday time.Weekday) *ParseTime {
+	pt.weekStart = weekday
+
+	return pt
+}
+
+// daysSince returns the number of days from the weekday start to the weekday
+func daysSince(start, weekday time.Weekday) int {
+	return (int(weekday) - int(start) + 7) % 7
+}
+
+// weekDate returns the date of the weekday in the week of the year
+func weekDate(year, week int, weekday time.Weekday, numbering WeekNumbering, weekStart time.Weekday) (int, int, int) {
+	var start time.Time
+	var days int
+
+	switch numbering {
+	case WeekNumberingUS:
+		jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		start = jan1.AddDate(0, 0, -daysSince(weekStart, jan1.Weekday()))
+		days = daysSince(weekStart, weekday)
+	default:
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		start = jan4.AddDate(0, 0, -daysSince(time.Monday, jan4.Weekday()))
+		days = daysSince(time.Monday, weekday)
+	}
+
+	y, m, d := start.AddDate(0, 0, (week-1)*7+days).Date()
+
+	return y, int(m), d
+}
+
+// hasWeek reports whether the year has the week.
+// Week 53 exists only in the years with 53 weeks for WeekNumberingISO, and always starts in the year for WeekNumberingUS.
+func hasWeek(year, week int, numbering WeekNumbering) bool {
+	if week < 1 || week > 53 {
+		return false
+	}
+
+	if week < 53 || numbering == WeekNumberingUS {
+		return true
+	}
+
+	// December 28 is always in the last ISO8601 week
+	_, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+
+	return weeks == 53
+}
+
+func (pt *ParseTime) parseWeek(value string) (dateTime, int, error) {
+	var dt dateTime
+	var priority int
+
+	group := findSubmatch(reWeek, value, 3)
+
+	if len(group) == 0 {
+		return dt, priority, errInvalidDateTime
+	}
+
+	priority = stringLen(value) - stringLen(group[0])
+
+	week, err := strconv.Atoi(group[1])
+	if err != nil {
+		return dt, priority, errInvalidDateTime
+	}
+
+	weekday, ok := Weekdays[strings.ToLower(group[2])]
+	if !ok {
+		return dt, priority, errInvalidDateTime
+	}
+
+	year, err := strconv.Atoi(group[3])
+	if err != nil {
+		return dt, priority, err
+	}
+
+	if !hasWeek(year, week, pt.weekNumbering) {
+		return dt, priority, errInvalidDateTime
+	}
+
+	year, month, day := weekDate(year, week, weekday, pt.weekNumbering, pt.weekStart)
+
+	return dateTime{
+		year:    year,
+		month:   month,
+		day:     day,
+		loc:     pt.location,
+		matched: group[0],
+	}, priority, nil
+}
+
+// Week parses week number, weekday name and year (e.g. "Week 3 Monday 2024")
+func (pt *ParseTime) Week(value string) (time.Time, error) {
+	return pt.parseFormat((*ParseTime).parseWeek, value)
+}
+
+// firstWeekday returns the first day of the week of the week numbering
+func (pt *ParseTime) firstWeekday() time.Weekday {
+	if pt.weekNumbering == WeekNumberingUS {
+		return pt.weekStart
+	}
+
+	return time.Monday
+}
+
+// ParseWeekStart parses date/time string like Parse, and returns midnight of the first day of its week in its location:
+// Monday for WeekNumberingISO, and the week start set by SetWeekStart for WeekNumberingUS
+func (pt *ParseTime) ParseWeekStart(value string) (time.Time, error) {
+	t, err := pt.Parse(value)
+	if err != nil {
+		return t, err
+	}
+
+	year, month, day := t.Date()
+	day -= daysSince(pt.firstWeekday(), t.Weekday())
+
+	// time.Date keeps midnight across a DST change, unlike subtracting 24 hours a day
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location()), nil
+}
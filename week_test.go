@@ -0,0 +1,170 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeek(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{
+			Value: "Week 3 Monday 2024",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "week 1 sun 2021",
+			Time:  time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Week 3 Monday 2022",
+			Time:  time.Date(2022, 1, 17, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Week(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+
+		t, err = p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+
+	_, err := p.Week("Week 3 Someday 2024")
+	assert.Equal(errInvalidDateTime, err, "Invalid date/time")
+
+	// 2020 has 53 ISO weeks and 2021 has 52
+	t, err := p.Week("Week 53 Monday 2020")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	for _, value := range []string{"Week 53 Monday 2021", "Week 54 Monday 2020"} {
+		_, err = p.Week(value)
+		assert.Equal(errInvalidDateTime, err, "Invalid date/time: "+value)
+
+		_, err = p.Parse(value)
+		assert.NotEqual(nil, err, "Invalid date/time: "+value)
+	}
+}
+
+func TestSetWeekNumbering(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.Parse("Week 3 Monday 2022")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2022, 1, 17, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetWeekNumbering(WeekNumberingUS)
+
+	t, err = p.Parse("Week 3 Monday 2022")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("Week 1 Sunday 2022")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2021, 12, 26, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestSetWeekStart(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetWeekNumbering(WeekNumberingUS)
+
+	t, err := p.Parse("Week 3 Sunday 2022")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2022, 1, 9, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetWeekStart(time.Monday)
+
+	t, err = p.Parse("Week 3 Sunday 2022")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2022, 1, 16, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	t, err = p.Parse("Week 1 Monday 2022")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	// ISO weeks always start on Monday
+	p.SetWeekNumbering(WeekNumberingISO)
+	p.SetWeekStart(time.Sunday)
+
+	t, err = p.Parse("Week 3 Sunday 2022")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2022, 1, 23, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestParseWeekStart(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []TestTime{
+		{
+			Value: "2024-01-17T14:30:00Z",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-15T00:00:00Z",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-21T23:59:59Z",
+			Time:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2024-01-03",
+			Time:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "2023-01-01",
+			Time:  time.Date(2022, 12, 26, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.ParseWeekStart(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time, t, "Parse error: "+tt.Value)
+	}
+
+	p.SetWeekNumbering(WeekNumberingUS)
+
+	t, err := p.ParseWeekStart("2024-01-17T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC), t, "Parse error")
+
+	p.SetWeekStart(time.Saturday)
+
+	t, err = p.ParseWeekStart("2024-01-17T14:30:00Z")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC), t, "Parse error")
+
+	// the week of 2024-03-10, when DST started in New York
+	newYork := createLocation("America/New_York")
+	p.WithLocation(newYork).SetWeekNumbering(WeekNumberingISO)
+
+	t, err = p.ParseWeekStart("2024-03-14 12:00:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 11, 0, 0, 0, 0, newYork).Unix(), t.Unix(), "Parse error")
+
+	p.SetWeekNumbering(WeekNumberingUS).SetWeekStart(time.Sunday)
+
+	t, err = p.ParseWeekStart("2024-03-14 12:00:00")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 3, 10, 0, 0, 0, 0, newYork).Unix(), t.Unix(), "Parse error")
+	assert.Equal(0, t.Hour(), "Parse error")
+	assert.Equal(-5*3600, getOffset(t), "Incorrect offset")
+
+	_, err = p.ParseWeekStart("garbage")
+	assert.NotEqual(nil, err, "Parse error")
+}
@@ -0,0 +1,46 @@
+package parsetime
+
+import (
+	"errors"
+	"time"
+)
+
+var errTwoDigitYear = errors.New("Two-digit year")
+
+// YearInferencePolicy is how the year of a date without a year (e.g. "Dec 31 23:59:59") is inferred
+type YearInferencePolicy int
+
+const (
+	// YearInferenceCurrent uses the current year
+	YearInferenceCurrent YearInferencePolicy = iota
+	// YearInferenceMostRecentPast uses the most recent year in which the date is not in the future
+	YearInferenceMostRecentPast
+)
+
+// SetYearInferencePolicy sets how the year of a date without a year is inferred
+func (pt *ParseTime) SetYearInferencePolicy(policy YearInferencePolicy) *ParseTime {
+	pt.yearInferencePolicy = policy
+
+	return pt
+}
+
+// SetRejectTwoDigitYear sets whether a two-digit year (e.g. "Jan 2, 06") is an error instead of being expanded to four digits
+func (pt *ParseTime) SetRejectTwoDigitYear(reject bool) *ParseTime {
+	pt.rejectTwoDigitYear = reject
+
+	return pt
+}
+
+// inferYear returns the year of dt, whose year was missing in the input
+func (pt *ParseTime) inferYear(dt dateTime) int {
+	if pt.yearInferencePolicy != YearInferenceMostRecentPast {
+		return dt.year
+	}
+
+	t := time.Date(dt.year, time.Month(dt.month), dt.day, dt.hour, dt.min, dt.sec, dt.nsec, dt.loc)
+	if t.After(pt.now()) {
+		return dt.year - 1
+	}
+
+	return dt.year
+}
@@ -0,0 +1,124 @@
+package parsetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetYearInferencePolicy(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC)))
+
+	t, err := p.Parse("Dec 31 23:59:59")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetYearInferencePolicy(YearInferenceMostRecentPast)
+
+	times := []TestTime{
+		{
+			Value: "Dec 31 23:59:59",
+			Time:  time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			Value: "Jan  5 09:00:00",
+			Time:  time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Jan 5 11:00:00",
+			Time:  time.Date(2023, 1, 5, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Dec 31 23:59:59 2024",
+			Time:  time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+}
+
+func TestParseWithYear(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+	p.SetClock(FixedClock(time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC)))
+	p.SetYearInferencePolicy(YearInferenceMostRecentPast)
+
+	times := []TestTime{
+		{
+			Value: "Dec 31 23:59:59",
+			Time:  time.Date(2019, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			Value: "Jan  5 09:00:00",
+			Time:  time.Date(2019, 1, 5, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Dec 31 23:59:59 2024",
+			Time:  time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			Value: "2006-01-02 15:04:05",
+			Time:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.ParseWithYear(tt.Value, 2019)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+}
+
+func TestSetRejectTwoDigitYear(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.Parse("Jan 2, 06")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+
+	p.SetRejectTwoDigitYear(true)
+
+	for _, value := range []string{"Jan 2, 06", "01/02/06 15:04", "01/15/24", "Jan 15 24", "Mon, 02 Jan 06 15:04:05 -0700", "02-Jan-'06"} {
+		_, err = p.Parse(value)
+		assert.Equal(errTwoDigitYear, err, "Two-digit year: "+value)
+	}
+
+	_, err = p.US("Jan 2, 06")
+	assert.Equal(errTwoDigitYear, err, "Two-digit year")
+
+	times := []TestTime{
+		{
+			Value: "Jan 2, 2006",
+			Time:  time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Value: "Mon, 02 Jan 2006 15:04:05 -0700",
+			Time:  time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC),
+		},
+		{
+			Value: "2006-01-02T15:04:05Z",
+			Time:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			Value: "2006-01-02 15:04:05",
+			Time:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range times {
+		t, err := p.Parse(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), t.Unix(), "Parse error: "+tt.Value)
+	}
+}
@@ -0,0 +1,120 @@
+package parsetime
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// zoneNames maps the lower-cased long timezone names to IANA timezone names or abbreviations
+var zoneNames = map[string]string{
+	"eastern time":           "America/New_York",
+	"eastern standard time":  "EST",
+	"eastern daylight time":  "EDT",
+	"central time":           "America/Chicago",
+	"central standard time":  "CST",
+	"central daylight time":  "CDT",
+	"mountain time":          "America/Denver",
+	"mountain standard time": "MST",
+	"mountain daylight time": "MDT",
+	"pacific time":           "America/Los_Angeles",
+	"pacific standard time":  "PST",
+	"pacific daylight time":  "PDT",
+	"alaska time":            "America/Anchorage",
+	"alaska standard time":   "AKST",
+	"alaska daylight time":   "AKDT",
+	"hawaii time":            "Pacific/Honolulu",
+	"hawaii standard time":   "HAST",
+}
+
+var reZoneName = regexp.MustCompile(zoneNamePattern())
+
+// zoneNamePattern returns the pattern of the long timezone names, longer names first
+func zoneNamePattern() string {
+	names := make([]string, 0, len(zoneNames))
+	for name := range zoneNames {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+		return names[i] < names[j]
+	})
+
+	return `(?i)(?:^|\s)(` + strings.Join(names, "|") + `)\s*$`
+}
+
+// RegisterZoneName registers a long timezone name (e.g. "Eastern Time") for an IANA timezone name ("America/New_York") or an abbreviation ("EST").
+// Names are case-insensitive. It is safe to call concurrently with parsing.
+func RegisterZoneName(name, zone string) error {
+	if _, err := resolveZone(zone); err != nil {
+		return err
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	zoneNames[strings.ToLower(name)] = zone
+	reZoneName = regexp.MustCompile(zoneNamePattern())
+
+	return nil
+}
+
+// resolveZone returns the location of an IANA timezone name or an offset/abbreviation
+func resolveZone(zone string) (*time.Location, error) {
+	if loc, err := ParseLocation(zone); err == nil {
+		return loc, nil
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, errInvalidTimezone
+	}
+
+	return loc, nil
+}
+
+// lookupZoneName returns the location of the registered long timezone name
+func lookupZoneName(name string) (*time.Location, bool) {
+	registryMu.RLock()
+	zone, ok := zoneNames[strings.ToLower(strings.TrimSpace(name))]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	loc, err := resolveZone(zone)
+	return loc, err == nil
+}
+
+// splitZoneName splits a trailing long timezone name from value,
+// and returns the rest, the name and the removed suffix (e.g. "2024-01-15 Eastern Time" -> "2024-01-15", "Eastern Time", " Eastern Time")
+func splitZoneName(value string) (string, string, string) {
+	index := registered(&reZoneName).FindStringSubmatchIndex(value)
+	if index == nil {
+		return value, "", ""
+	}
+
+	return value[:index[0]], value[index[2]:index[3]], value[index[0]:]
+}
+
+// withTrailingZone uses the trailing zone (a comment or a long timezone name) as the timezone of dt if dt has no offset/timezone,
+// and adds the removed suffix to the matched string
+func withTrailingZone(dt dateTime, value, zone, suffix string) dateTime {
+	if dt.zone() == "" {
+		if loc, err := ParseLocation(zone); err == nil {
+			dt.loc, dt.abbr = loc, zone
+		} else if loc, ok := lookupZoneName(zone); ok {
+			dt.loc, dt.abbr = loc, zone
+		}
+	}
+
+	if strings.HasSuffix(value, dt.matched) {
+		dt.matched += suffix
+	}
+
+	return dt
+}
@@ -0,0 +1,114 @@
+package parsetime
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseZoneName(test *testing.T) {
+	assert := assert.New(test)
+
+	p, _ := NewParseTime(time.UTC)
+
+	times := []struct {
+		Value  string
+		Time   time.Time
+		Offset int
+	}{
+		{
+			Value:  "2024-01-15 14:30:00 Eastern Time",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("EST", -5*3600)),
+			Offset: -5 * 3600,
+		},
+		{
+			Value:  "2024-07-15 14:30:00 eastern time",
+			Time:   time.Date(2024, 7, 15, 14, 30, 0, 0, time.FixedZone("EDT", -4*3600)),
+			Offset: -4 * 3600,
+		},
+		{
+			Value:  "Jan 15, 2024 2:30 PM Pacific Daylight Time",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("PDT", -7*3600)),
+			Offset: -7 * 3600,
+		},
+		{
+			// the offset is used rather than the name
+			Value:  "2024-01-15 14:30:00+09:00 Eastern Time",
+			Time:   time.Date(2024, 1, 15, 14, 30, 0, 0, time.FixedZone("", 9*3600)),
+			Offset: 9 * 3600,
+		},
+	}
+
+	for _, tt := range times {
+		r, err := p.ParseDetailed(tt.Value)
+		assert.Equal(nil, err, "Invalid date/time: "+tt.Value)
+		assert.Equal(tt.Time.Unix(), r.Time.Unix(), "Parse error: "+tt.Value)
+		_, offset := r.Time.Zone()
+		assert.Equal(tt.Offset, offset, "Parse error: "+tt.Value)
+		assert.Equal(true, r.ExplicitZone, "Parse error: "+tt.Value)
+		assert.Equal("", r.Leftover, "Leftover error: "+tt.Value)
+	}
+}
+
+func TestRegisterZoneName(test *testing.T) {
+	assert := assert.New(test)
+
+	names := zoneNames
+	test.Cleanup(func() {
+		zoneNames = names
+		reZoneName = regexp.MustCompile(zoneNamePattern())
+	})
+	zoneNames = map[string]string{}
+	for name, zone := range names {
+		zoneNames[name] = zone
+	}
+
+	assert.Equal(errInvalidTimezone, RegisterZoneName("Nowhere Time", "Nowhere/Nowhere"), "Invalid timezone")
+
+	assert.Equal(nil, RegisterZoneName("Japan Standard Time", "Asia/Tokyo"), "Invalid timezone")
+
+	p, _ := NewParseTime(time.UTC)
+
+	t, err := p.Parse("2024-01-15 14:30:00 Japan Standard Time")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 5, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}
+
+func TestRegisterZoneNameConcurrent(test *testing.T) {
+	assert := assert.New(test)
+
+	names := zoneNames
+	test.Cleanup(func() {
+		zoneNames = names
+		reZoneName = regexp.MustCompile(zoneNamePattern())
+	})
+	zoneNames = map[string]string{}
+	for name, zone := range names {
+		zoneNames[name] = zone
+	}
+
+	p, _ := NewParseTime(time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			assert.Equal(nil, RegisterZoneName(fmt.Sprintf("Zone %d Time", i), "Asia/Tokyo"), "Invalid timezone")
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := p.Parse("2024-01-15 14:30:00 Eastern Time")
+			assert.Equal(nil, err, "Invalid date/time")
+		}()
+	}
+	wg.Wait()
+
+	t, err := p.Parse("2024-01-15 14:30:00 Zone 3 Time")
+	assert.Equal(nil, err, "Invalid date/time")
+	assert.Equal(time.Date(2024, 1, 15, 5, 30, 0, 0, time.UTC).Unix(), t.Unix(), "Parse error")
+}